@@ -14,24 +14,35 @@ import (
 type AddTemplateCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender         currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract       currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
-	TemplateID     string                      `arg:"" name:"template-id" help:"template id" required:"true"`
-	TemplateName   string                      `arg:"" name:"template-name" help:"template name"  required:"true"`
-	ServiceDate    string                      `arg:"" name:"service-date" help:"service date; yyyy-MM-dd" required:"true"`
-	ExpirationDate string                      `arg:"" name:"expiration-date" help:"expiration date; yyyy-MM-dd" required:"true"`
-	TemplateShare  bool                        `name:"template-share" help:"template share; true | false" required:"true"`
-	MultiAudit     bool                        `name:"multi-audit" help:"multi audit; true | false" required:"true"`
-	DisplayName    string                      `arg:"" name:"display-name" help:"display name" required:"true"`
-	SubjectKey     string                      `arg:"" name:"subject-key" help:"subject key" required:"true"`
-	Description    string                      `arg:"" name:"description" help:"description"  required:"true"`
-	Creator        currencycmds.AddressFlag    `arg:"" name:"creator" help:"creator address"  required:"true"`
-	Currency       currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	sender         base.Address
-	contract       base.Address
-	serviceDate    types.Date
-	expiration     types.Date
-	creator        base.Address
+	Sender              currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract            currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID          string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	TemplateName        string                      `arg:"" name:"template-name" help:"template name"  required:"true"`
+	ServiceDate         string                      `arg:"" name:"service-date" help:"service date; yyyy-MM-dd" required:"true"`
+	ExpirationDate      string                      `arg:"" name:"expiration-date" help:"expiration date; yyyy-MM-dd" required:"true"`
+	TemplateShare       bool                        `name:"template-share" help:"template share; true | false" required:"true"`
+	MultiAudit          bool                        `name:"multi-audit" help:"multi audit; true | false" required:"true"`
+	ConsentRequired     bool                        `name:"consent-required" help:"require consent signature of holder to assign credential"`
+	PrivateValue        bool                        `name:"private-value" help:"keep only salted hash commitment of credential value on chain"`
+	SelectiveDisclosure bool                        `name:"selective-disclosure" help:"keep only digests of salted claims of credential value on chain"`
+	Auditors            []currencycmds.AddressFlag  `name:"auditor" help:"auditor address of multi audit template"`
+	AuditThreshold      uint                        `name:"audit-threshold" help:"number of auditor approvals to activate credential"`
+	MaxSupply           uint64                      `name:"max-supply" help:"max number of credentials issued of template; no limit if 0"`
+	MaxPerHolder        uint64                      `name:"max-per-holder" help:"max number of credentials of template for each holder; no limit if 0"`
+	Schema              string                      `name:"schema" help:"json schema of credential value"`
+	DisplayName         string                      `arg:"" name:"display-name" help:"display name" required:"true"`
+	SubjectKey          string                      `arg:"" name:"subject-key" help:"subject key" required:"true"`
+	Description         string                      `arg:"" name:"description" help:"description"  required:"true"`
+	Creator             currencycmds.AddressFlag    `arg:"" name:"creator" help:"creator address"  required:"true"`
+	Currency            currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender              base.Address
+	contract            base.Address
+	serviceDate         types.Date
+	expiration          types.Date
+	auditorSet          *types.AuditorSet
+	quota               *types.Quota
+	schema              *types.CredentialSchema
+	creator             base.Address
 }
 
 func (cmd *AddTemplateCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -89,6 +100,24 @@ func (cmd *AddTemplateCommand) parseFlags() error {
 	cmd.serviceDate = serviceDate
 	cmd.expiration = expiration
 
+	auditorSet, err := parseAuditorSet(cmd.Auditors, cmd.AuditThreshold)
+	if err != nil {
+		return err
+	}
+	cmd.auditorSet = auditorSet
+
+	quota, err := parseQuota(cmd.MaxSupply, cmd.MaxPerHolder)
+	if err != nil {
+		return err
+	}
+	cmd.quota = quota
+
+	schema, err := parseCredentialSchema(cmd.Schema)
+	if err != nil {
+		return err
+	}
+	cmd.schema = schema
+
 	return nil
 }
 
@@ -105,6 +134,12 @@ func (cmd *AddTemplateCommand) createOperation() (base.Operation, error) { // no
 		cmd.expiration,
 		types.Bool(cmd.TemplateShare),
 		types.Bool(cmd.MultiAudit),
+		types.Bool(cmd.ConsentRequired),
+		types.Bool(cmd.PrivateValue),
+		types.Bool(cmd.SelectiveDisclosure),
+		cmd.auditorSet,
+		cmd.quota,
+		cmd.schema,
 		cmd.DisplayName,
 		cmd.SubjectKey,
 		cmd.Description,
@@ -124,3 +159,51 @@ func (cmd *AddTemplateCommand) createOperation() (base.Operation, error) { // no
 
 	return op, nil
 }
+
+func parseAuditorSet(flags []currencycmds.AddressFlag, threshold uint) (*types.AuditorSet, error) {
+	if len(flags) < 1 {
+		return nil, nil
+	}
+
+	auditors := make([]base.Address, len(flags))
+	for i := range flags {
+		a, err := flags[i].Encode(enc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid auditor format, %q", flags[i].String())
+		}
+		auditors[i] = a
+	}
+
+	auditorSet := types.NewAuditorSet(auditors, threshold)
+	if err := auditorSet.IsValid(nil); err != nil {
+		return nil, errors.Wrap(err, "invalid auditor set")
+	}
+
+	return &auditorSet, nil
+}
+
+func parseQuota(maxSupply, maxPerHolder uint64) (*types.Quota, error) {
+	if maxSupply < 1 && maxPerHolder < 1 {
+		return nil, nil
+	}
+
+	quota := types.NewQuota(maxSupply, maxPerHolder)
+	if err := quota.IsValid(nil); err != nil {
+		return nil, errors.Wrap(err, "invalid quota")
+	}
+
+	return &quota, nil
+}
+
+func parseCredentialSchema(s string) (*types.CredentialSchema, error) {
+	if len(s) < 1 {
+		return nil, nil
+	}
+
+	schema := types.NewCredentialSchema(s)
+	if err := schema.IsValid(nil); err != nil {
+		return nil, errors.Wrap(err, "invalid credential schema")
+	}
+
+	return &schema, nil
+}
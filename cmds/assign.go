@@ -5,6 +5,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util"
 
 	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
 	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/pkg/errors"
@@ -23,9 +24,15 @@ type AssignCommand struct {
 	ValidUntil uint64                      `arg:"" name:"valid-until" help:"valid until" required:"true"`
 	DID        string                      `arg:"" name:"did" help:"did" required:"true"`
 	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	HolderKeys []string                    `name:"holder-privatekey" help:"privatekey of holder to sign consent"`
+	Salt       string                      `name:"salt" help:"salt to commit value of private value template; value is committed if given"`
+	IssuerKey  string                      `name:"issuer-privatekey" help:"privatekey of owner or operator of contract account to sign credential"`
 	sender     base.Address
 	contract   base.Address
 	holder     base.Address
+	holderKeys []base.Privatekey
+	issuerKey  base.Privatekey
+	value      string
 }
 
 func (cmd *AssignCommand) Run(pctx context.Context) error {
@@ -73,6 +80,28 @@ func (cmd *AssignCommand) parseFlags() error {
 	}
 	cmd.holder = holder
 
+	for _, s := range cmd.HolderKeys {
+		priv, err := base.DecodePrivatekeyFromString(s, enc)
+		if err != nil {
+			return errors.Wrap(err, "invalid holder privatekey")
+		}
+		cmd.holderKeys = append(cmd.holderKeys, priv)
+	}
+
+	if len(cmd.IssuerKey) > 0 {
+		priv, err := base.DecodePrivatekeyFromString(cmd.IssuerKey, enc)
+		if err != nil {
+			return errors.Wrap(err, "invalid issuer privatekey")
+		}
+		cmd.issuerKey = priv
+	}
+
+	value, err := commitValue(cmd.Value, cmd.Salt)
+	if err != nil {
+		return err
+	}
+	cmd.value = value
+
 	return nil
 }
 
@@ -85,12 +114,47 @@ func (cmd *AssignCommand) createOperation() (base.Operation, error) { // nolint:
 		cmd.holder,
 		cmd.TemplateID,
 		cmd.ID,
-		cmd.Value,
+		cmd.value,
 		cmd.ValidFrom,
 		cmd.ValidUntil,
 		cmd.DID,
+		nil,
+		nil,
 		cmd.Currency.CID,
 	)
+
+	var signs []base.BaseSign
+	for i := range cmd.holderKeys {
+		sign, err := base.NewBaseSignFromBytes(cmd.holderKeys[i], cmd.NetworkID.NetworkID(), item.ConsentBytes(0))
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+		signs = append(signs, sign)
+	}
+
+	var issuerSign *types.IssuerSign
+	if cmd.issuerKey != nil {
+		sign, err := types.NewIssuerSignFromCredential(cmd.issuerKey, cmd.NetworkID.NetworkID(), cmd.contract, item.Credential())
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+		issuerSign = &sign
+	}
+
+	item = credential.NewAssignItem(
+		cmd.contract,
+		cmd.holder,
+		cmd.TemplateID,
+		cmd.ID,
+		cmd.value,
+		cmd.ValidFrom,
+		cmd.ValidUntil,
+		cmd.DID,
+		signs,
+		issuerSign,
+		cmd.Currency.CID,
+	)
+
 	if err := item.IsValid(nil); err != nil {
 		return nil, err
 	}
@@ -110,3 +174,17 @@ func (cmd *AssignCommand) createOperation() (base.Operation, error) { // nolint:
 
 	return op, nil
 }
+
+// commitValue returns the commitment of value for the private value template
+// if salt is given, otherwise value itself.
+func commitValue(value, salt string) (string, error) {
+	if len(salt) < 1 {
+		return value, nil
+	}
+
+	if err := types.IsValidValueSalt(salt); err != nil {
+		return "", errors.Wrap(err, "invalid salt")
+	}
+
+	return types.NewValueCommitment(value, salt), nil
+}
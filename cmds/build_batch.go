@@ -0,0 +1,120 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type BuildBatchCommand struct {
+	BaseCommand
+	Credentials string `arg:"" name:"credentials" help:"json array of credentials; holder, template_id, id, value, valid_from, valid_until and did" required:"true"`
+	File        bool   `name:"file" help:"read credentials from file of path"`
+}
+
+type BatchCredential struct {
+	Holder     string `json:"holder"`
+	TemplateID string `json:"template_id"`
+	ID         string `json:"id"`
+	Value      string `json:"value"`
+	ValidFrom  uint64 `json:"valid_from"`
+	ValidUntil uint64 `json:"valid_until"`
+	DID        string `json:"did"`
+}
+
+type BatchCredentialProof struct {
+	ID    string   `json:"id"`
+	Index uint64   `json:"index"`
+	Proof []string `json:"proof"`
+}
+
+type BuildBatchResult struct {
+	// Root and Count are anchored by anchor-batch.
+	Root  string `json:"root"`
+	Count uint64 `json:"count"`
+	// Proofs are delivered to the holders with the credentials.
+	Proofs []BatchCredentialProof `json:"proofs"`
+}
+
+func (cmd *BuildBatchCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	b := []byte(cmd.Credentials)
+	if cmd.File {
+		i, err := os.ReadFile(cmd.Credentials)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		b = i
+	}
+
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.DisallowUnknownFields()
+
+	var bcs []BatchCredential
+	if err := d.Decode(&bcs); err != nil {
+		return errors.Wrap(err, "credentials not json array")
+	}
+
+	if len(bcs) < 1 {
+		return errors.Errorf("empty credentials")
+	}
+
+	ids := map[string]struct{}{}
+	leaves := make([][]byte, len(bcs))
+
+	for i := range bcs {
+		c := bcs[i]
+
+		holder, err := base.DecodeAddress(c.Holder, enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid holder format, %d", i)
+		}
+
+		credential := types.NewCredential(holder, c.TemplateID, c.ID, c.Value, c.ValidFrom, c.ValidUntil, c.DID)
+		if err := credential.IsValid(nil); err != nil {
+			return errors.Wrapf(err, "invalid credential, %d", i)
+		}
+
+		if _, found := ids[c.ID]; found {
+			return errors.Errorf("duplicated credential id, %q", c.ID)
+		}
+		ids[c.ID] = struct{}{}
+
+		leaves[i] = types.CredentialLeaf(credential)
+	}
+
+	tree, err := types.NewMerkleTree(leaves)
+	if err != nil {
+		return err
+	}
+
+	proofs := make([]BatchCredentialProof, len(bcs))
+	for i := range bcs {
+		proof, err := tree.Proof(uint64(i))
+		if err != nil {
+			return err
+		}
+
+		proofs[i] = BatchCredentialProof{ID: bcs[i].ID, Index: uint64(i), Proof: types.EncodeMerkleProof(proof)}
+	}
+
+	PrettyPrint(cmd.Out, BuildBatchResult{
+		Root:   hex.EncodeToString(tree.Root()),
+		Count:  tree.Count(),
+		Proofs: proofs,
+	})
+
+	return nil
+}
@@ -13,11 +13,12 @@ import (
 type CreateServiceCommand struct {
 	BaseCommand
 	currencycmds.OperationFlags
-	Sender   currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
-	Contract currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
-	Currency currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
-	sender   base.Address
-	contract base.Address
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	DIDMethods []string                    `name:"did-method" help:"accepted did method, eg. mitum; every method is accepted if not set"`
+	sender     base.Address
+	contract   base.Address
 }
 
 func (cmd *CreateServiceCommand) Run(pctx context.Context) error { // nolint:dupl
@@ -69,6 +70,7 @@ func (cmd *CreateServiceCommand) createOperation() (base.Operation, error) { //
 		[]byte(cmd.Token),
 		cmd.sender,
 		cmd.contract,
+		cmd.DIDMethods,
 		cmd.Currency.CID,
 	)
 
@@ -1,8 +1,27 @@
 package cmds
 
 type CredentialCommand struct {
-	CreateService CreateServiceCommand     `cmd:"" name:"create-service" help:"register credential service to contract account"`
-	AddTemplate   AddTemplateCommand       `cmd:"" name:"add-template" help:"add template to credential service"`
-	Assign        AssignCommand            `cmd:"" name:"assign" help:"assign credential"`
-	Revoke        RevokeCredentialsCommand `cmd:"" name:"revoke" help:"revoke credential"`
+	CreateService        CreateServiceCommand        `cmd:"" name:"create-service" help:"register credential service to contract account"`
+	AddTemplate          AddTemplateCommand          `cmd:"" name:"add-template" help:"add template to credential service"`
+	UpdateTemplate       UpdateTemplateCommand       `cmd:"" name:"update-template" help:"update template of credential service"`
+	UpdateTemplateStatus UpdateTemplateStatusCommand `cmd:"" name:"update-template-status" help:"update status of template; active | deprecated | removed"`
+	GrantTemplate        GrantTemplateCommand        `cmd:"" name:"grant-template" help:"grant shared template to other credential service permanently"`
+	GrantRole            GrantRoleCommand            `cmd:"" name:"grant-role" help:"grant role of credential service to account"`
+	RevokeRole           RevokeRoleCommand           `cmd:"" name:"revoke-role" help:"revoke role of credential service from account"`
+	Assign               AssignCommand               `cmd:"" name:"assign" help:"assign credential"`
+	ApproveAssignment    ApproveAssignmentCommand    `cmd:"" name:"approve-assignment" help:"approve pending assignment of multi-audit template; signed by auditor"`
+	UpdateCredential     UpdateCredentialCommand     `cmd:"" name:"update-credential" help:"update value and validity of credential"`
+	Revoke               RevokeCredentialsCommand    `cmd:"" name:"revoke" help:"revoke credential"`
+	Renounce             RenounceCommand             `cmd:"" name:"renounce" help:"renounce credential; signed by holder"`
+	Suspend              SuspendCredentialsCommand   `cmd:"" name:"suspend" help:"suspend credential"`
+	Reinstate            ReinstateCredentialsCommand `cmd:"" name:"reinstate" help:"reinstate suspended credential"`
+	UpdateHolderDID      UpdateHolderDIDCommand      `cmd:"" name:"update-holder-did" help:"update did of holder for later credentials; signed by holder"`
+	ExportVC             ExportVCCommand             `cmd:"" name:"export-vc" help:"export credential as W3C verifiable credential"`
+	VerifyValue          VerifyValueCommand          `cmd:"" name:"verify-value" help:"verify credential value and salt against commitment of private value template"`
+	BuildBatch           BuildBatchCommand           `cmd:"" name:"build-batch" help:"build merkle root and inclusion proofs of credential batch"`
+	AnchorBatch          AnchorBatchCommand          `cmd:"" name:"anchor-batch" help:"anchor merkle root of credential batch"`
+	RevokeBatch          RevokeBatchCommand          `cmd:"" name:"revoke-batch" help:"revoke all credentials of anchored batch"`
+	IssueDisclosures     IssueDisclosuresCommand     `cmd:"" name:"issue-disclosures" help:"issue disclosures of claims for selective disclosure template"`
+	SignDisclosures      SignDisclosuresCommand      `cmd:"" name:"sign-disclosures" help:"sign disclosures of credential with challenge of relying party; signed by holder"`
+	SignPresentation     SignPresentationCommand     `cmd:"" name:"sign-presentation" help:"sign presentation of credentials with challenge of relying party; signed by holder"`
 }
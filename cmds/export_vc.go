@@ -0,0 +1,106 @@
+package cmds
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type ExportVCCommand struct {
+	BaseNetworkClientCommand
+	Contract   currencycmds.AddressFlag `arg:"" name:"contract" help:"contract account address" required:"true"`
+	TemplateID string                   `arg:"" name:"template-id" help:"template id" required:"true"`
+	ID         string                   `arg:"" name:"id" help:"credential id" required:"true"`
+	DigestURL  string                   `name:"digest-url" help:"digest api url for credentialStatus; omitted if empty"`
+	contract   base.Address
+}
+
+func (cmd *ExportVCCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = cmd.Client.Close()
+	}()
+
+	contract, err := cmd.Contract.Encode(cmd.Encoder)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	st, err := cmd.templateState(pctx, cmd.contract, cmd.TemplateID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get template, %q", cmd.TemplateID)
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return err
+	}
+
+	st, err = cmd.state(pctx, state.StateKeyCredential(cmd.contract, cmd.TemplateID, cmd.ID))
+	if err != nil {
+		return errors.Wrapf(err, "failed to get credential, %q", cmd.ID)
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return err
+	}
+
+	var statusListCredential string
+	if u := strings.TrimRight(cmd.DigestURL, "/"); len(u) > 0 {
+		statusListCredential = u + "/did/" + cmd.contract.String() + "/template/" + cmd.TemplateID + "/status-list"
+	}
+
+	return cmd.Print(
+		types.NewVerifiableCredential(
+			cmd.NetworkID, cmd.contract.String(), template, cv.Credential, cv.StatusIndex, statusListCredential, cv.IssuerSign,
+		),
+		os.Stdout,
+	)
+}
+
+// templateState returns the template of the credential service or, for the
+// granted template, of the owner service.
+func (cmd *BaseNetworkClientCommand) templateState(
+	pctx context.Context, contract base.Address, templateID string,
+) (base.State, error) {
+	if st, err := cmd.state(pctx, state.StateKeyTemplate(contract, templateID)); err == nil {
+		return st, nil
+	}
+
+	st, err := cmd.state(pctx, state.StateKeyTemplateGrant(contract, templateID))
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := state.StateTemplateGrantValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	return cmd.state(pctx, state.StateKeyTemplate(owner, templateID))
+}
+
+func (cmd *BaseNetworkClientCommand) state(pctx context.Context, key string) (base.State, error) {
+	ctx, cancel := context.WithTimeout(pctx, cmd.Timeout)
+	defer cancel()
+
+	switch st, found, err := cmd.Client.State(ctx, cmd.Remote.ConnInfo(), key, nil); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return nil, errors.Errorf("state not found, %q", key)
+	default:
+		return st, nil
+	}
+}
@@ -0,0 +1,127 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type GrantTemplateCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender      currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract    currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID  string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	Grantee     currencycmds.AddressFlag    `arg:"" name:"grantee" help:"contract address of grantee credential service" required:"true"`
+	Currency    currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	GranteeKeys []string                    `name:"grantee-privatekey" help:"privatekey of owner of grantee contract account to accept grant"`
+	sender      base.Address
+	contract    base.Address
+	grantee     base.Address
+	granteeKeys []base.Privatekey
+}
+
+func (cmd *GrantTemplateCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *GrantTemplateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	grantee, err := cmd.Grantee.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid grantee account format, %q", cmd.Grantee.String())
+	}
+	cmd.grantee = grantee
+
+	for _, s := range cmd.GranteeKeys {
+		priv, err := base.DecodePrivatekeyFromString(s, enc)
+		if err != nil {
+			return errors.Wrap(err, "invalid grantee privatekey")
+		}
+		cmd.granteeKeys = append(cmd.granteeKeys, priv)
+	}
+
+	return nil
+}
+
+func (cmd *GrantTemplateCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create grant-template operation")
+
+	fact := credential.NewGrantTemplateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.grantee,
+		nil,
+		cmd.Currency.CID,
+	)
+
+	var signs []base.BaseSign
+	for i := range cmd.granteeKeys {
+		sign, err := base.NewBaseSignFromBytes(cmd.granteeKeys[i], cmd.NetworkID.NetworkID(), fact.AcceptBytes())
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+		signs = append(signs, sign)
+	}
+
+	fact = credential.NewGrantTemplateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.grantee,
+		signs,
+		cmd.Currency.CID,
+	)
+
+	op, err := credential.NewGrantTemplate(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
@@ -15,10 +15,17 @@ var SupportedProposalOperationFactHinters []encoder.DecodeDetail
 
 var AddedHinters = []encoder.DecodeDetail{
 	// revive:disable-next-line:line-length-limit
+	{Hint: types.AuditorSetHint, Instance: types.AuditorSet{}},
 	{Hint: types.CredentialHint, Instance: types.Credential{}},
 	{Hint: types.DesignHint, Instance: types.Design{}},
 	{Hint: types.HolderHint, Instance: types.Holder{}},
 	{Hint: types.PolicyHint, Instance: types.Policy{}},
+	{Hint: types.QuotaHint, Instance: types.Quota{}},
+	{Hint: types.CredentialSchemaHint, Instance: types.CredentialSchema{}},
+	{Hint: types.IssuerSignHint, Instance: types.IssuerSign{}},
+	{Hint: types.RevocationHint, Instance: types.Revocation{}},
+	{Hint: types.RoleGrantHint, Instance: types.RoleGrant{}},
+	{Hint: types.StatusListHint, Instance: types.StatusList{}},
 	{Hint: types.TemplateHint, Instance: types.Template{}},
 
 	{Hint: credential.CreateServiceHint, Instance: credential.CreateService{}},
@@ -27,18 +34,56 @@ var AddedHinters = []encoder.DecodeDetail{
 	{Hint: credential.AssignHint, Instance: credential.Assign{}},
 	{Hint: credential.RevokeItemHint, Instance: credential.RevokeItem{}},
 	{Hint: credential.RevokeHint, Instance: credential.Revoke{}},
+	{Hint: credential.UpdateTemplateHint, Instance: credential.UpdateTemplate{}},
+	{Hint: credential.UpdateTemplateStatusHint, Instance: credential.UpdateTemplateStatus{}},
+	{Hint: credential.UpdateCredentialItemHint, Instance: credential.UpdateCredentialItem{}},
+	{Hint: credential.UpdateCredentialHint, Instance: credential.UpdateCredential{}},
+	{Hint: credential.SuspendItemHint, Instance: credential.SuspendItem{}},
+	{Hint: credential.SuspendHint, Instance: credential.Suspend{}},
+	{Hint: credential.ReinstateItemHint, Instance: credential.ReinstateItem{}},
+	{Hint: credential.ReinstateHint, Instance: credential.Reinstate{}},
+	{Hint: credential.UpdateHolderDIDHint, Instance: credential.UpdateHolderDID{}},
+	{Hint: credential.RenounceHint, Instance: credential.Renounce{}},
+	{Hint: credential.ApproveAssignmentHint, Instance: credential.ApproveAssignment{}},
+	{Hint: credential.GrantTemplateHint, Instance: credential.GrantTemplate{}},
+	{Hint: credential.GrantRoleHint, Instance: credential.GrantRole{}},
+	{Hint: credential.RevokeRoleHint, Instance: credential.RevokeRole{}},
+	{Hint: credential.AnchorBatchHint, Instance: credential.AnchorBatch{}},
+	{Hint: credential.RevokeBatchHint, Instance: credential.RevokeBatch{}},
 
+	{Hint: state.BatchAnchorStateValueHint, Instance: state.BatchAnchorStateValue{}},
 	{Hint: state.CredentialStateValueHint, Instance: state.CredentialStateValue{}},
 	{Hint: state.DesignStateValueHint, Instance: state.DesignStateValue{}},
 	{Hint: state.HolderDIDStateValueHint, Instance: state.HolderDIDStateValue{}},
+	{Hint: state.DIDHolderStateValueHint, Instance: state.DIDHolderStateValue{}},
+	{Hint: state.HolderStatStateValueHint, Instance: state.HolderStatStateValue{}},
+	{Hint: state.PendingAssignmentStateValueHint, Instance: state.PendingAssignmentStateValue{}},
+	{Hint: state.TemplateGrantStateValueHint, Instance: state.TemplateGrantStateValue{}},
+	{Hint: state.RolesStateValueHint, Instance: state.RolesStateValue{}},
+	{Hint: state.TemplateStatStateValueHint, Instance: state.TemplateStatStateValue{}},
+	{Hint: state.StatusListStateValueHint, Instance: state.StatusListStateValue{}},
+	{Hint: state.StatusListSizeStateValueHint, Instance: state.StatusListSizeStateValue{}},
 	{Hint: state.TemplateStateValueHint, Instance: state.TemplateStateValue{}},
 }
 
 var AddedSupportedHinters = []encoder.DecodeDetail{
 	{Hint: credential.AddTemplateFactHint, Instance: credential.AddTemplateFact{}},
+	{Hint: credential.AnchorBatchFactHint, Instance: credential.AnchorBatchFact{}},
+	{Hint: credential.ApproveAssignmentFactHint, Instance: credential.ApproveAssignmentFact{}},
 	{Hint: credential.AssignFactHint, Instance: credential.AssignFact{}},
 	{Hint: credential.CreateServiceFactHint, Instance: credential.CreateServiceFact{}},
+	{Hint: credential.GrantRoleFactHint, Instance: credential.GrantRoleFact{}},
+	{Hint: credential.GrantTemplateFactHint, Instance: credential.GrantTemplateFact{}},
+	{Hint: credential.ReinstateFactHint, Instance: credential.ReinstateFact{}},
+	{Hint: credential.RenounceFactHint, Instance: credential.RenounceFact{}},
+	{Hint: credential.RevokeBatchFactHint, Instance: credential.RevokeBatchFact{}},
 	{Hint: credential.RevokeFactHint, Instance: credential.RevokeFact{}},
+	{Hint: credential.RevokeRoleFactHint, Instance: credential.RevokeRoleFact{}},
+	{Hint: credential.SuspendFactHint, Instance: credential.SuspendFact{}},
+	{Hint: credential.UpdateCredentialFactHint, Instance: credential.UpdateCredentialFact{}},
+	{Hint: credential.UpdateHolderDIDFactHint, Instance: credential.UpdateHolderDIDFact{}},
+	{Hint: credential.UpdateTemplateFactHint, Instance: credential.UpdateTemplateFact{}},
+	{Hint: credential.UpdateTemplateStatusFactHint, Instance: credential.UpdateTemplateStatusFact{}},
 }
 
 func init() {
@@ -0,0 +1,62 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/pkg/errors"
+)
+
+type IssueDisclosuresCommand struct {
+	BaseCommand
+	Claims string `arg:"" name:"claims" help:"claims of credential; json object" required:"true"`
+}
+
+type IssueDisclosuresResult struct {
+	// Value is the credential value to assign with selective disclosure
+	// template.
+	Value string `json:"value"`
+	// Disclosures are kept by holder to disclose the claims.
+	Disclosures map[string]string `json:"disclosures"`
+}
+
+func (cmd *IssueDisclosuresCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	d := json.NewDecoder(bytes.NewReader([]byte(cmd.Claims)))
+	d.UseNumber()
+
+	var claims map[string]interface{}
+	if err := d.Decode(&claims); err != nil {
+		return errors.Wrap(err, "claims not json object")
+	}
+
+	if len(claims) < 1 {
+		return errors.Errorf("empty claims")
+	}
+
+	v, disclosures, err := types.NewDisclosures(claims)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	if _, err := types.ParseSDValue(string(b)); err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, IssueDisclosuresResult{Value: string(b), Disclosures: disclosures})
+
+	return nil
+}
@@ -2,6 +2,7 @@ package cmds
 
 import (
 	"context"
+	"time"
 
 	"github.com/ProtoconNet/mitum-credential/operation/credential"
 	"github.com/ProtoconNet/mitum-credential/operation/processor"
@@ -9,10 +10,12 @@ import (
 	currencyprocessor "github.com/ProtoconNet/mitum-currency/v3/operation/processor"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/isaac"
+	isaacdatabase "github.com/ProtoconNet/mitum2/isaac/database"
 	"github.com/ProtoconNet/mitum2/launch"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/ProtoconNet/mitum2/util/ps"
+	"github.com/pkg/errors"
 )
 
 var PNameOperationProcessorsMap = ps.Name("mitum-credential-operation-processors-map")
@@ -20,18 +23,24 @@ var PNameOperationProcessorsMap = ps.Name("mitum-credential-operation-processors
 func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 	var isaacParams *isaac.Params
 	var db isaac.Database
+	var pool *isaacdatabase.TempPool
+	var lvps *isaac.LastVoteproofsHandler
 	var opr *currencyprocessor.OperationProcessor
 	var set *hint.CompatibleSet[isaac.NewOperationProcessorInternalFunc]
 
 	if err := util.LoadFromContextOK(pctx,
 		launch.ISAACParamsContextKey, &isaacParams,
 		launch.CenterDatabaseContextKey, &db,
+		launch.PoolDatabaseContextKey, &pool,
+		launch.LastVoteproofsHandlerContextKey, &lvps,
 		currencycmds.OperationProcessorContextKey, &opr,
 		launch.OperationProcessorsMapContextKey, &set,
 	); err != nil {
 		return pctx, err
 	}
 
+	proposedAt := proposedAtFunc(db, pool, lvps)
+
 	err := opr.SetCheckDuplicationFunc(processor.CheckDuplication)
 	if err != nil {
 		return pctx, err
@@ -52,7 +61,7 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		return pctx, err
 	} else if err := opr.SetProcessor(
 		credential.AssignHint,
-		credential.NewAssignProcessor(),
+		credential.NewAssignProcessor(proposedAt, isaacParams.NetworkID()),
 	); err != nil {
 		return pctx, err
 	} else if err := opr.SetProcessor(
@@ -60,6 +69,71 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		credential.NewRevokeProcessor(),
 	); err != nil {
 		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.UpdateTemplateHint,
+		credential.NewUpdateTemplateProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.UpdateTemplateStatusHint,
+		credential.NewUpdateTemplateStatusProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.UpdateCredentialHint,
+		credential.NewUpdateCredentialProcessor(proposedAt, isaacParams.NetworkID()),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.SuspendHint,
+		credential.NewSuspendProcessor(proposedAt),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ReinstateHint,
+		credential.NewReinstateProcessor(proposedAt),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.UpdateHolderDIDHint,
+		credential.NewUpdateHolderDIDProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RenounceHint,
+		credential.NewRenounceProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.ApproveAssignmentHint,
+		credential.NewApproveAssignmentProcessor(proposedAt),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.GrantTemplateHint,
+		credential.NewGrantTemplateProcessor(isaacParams.NetworkID()),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.GrantRoleHint,
+		credential.NewGrantRoleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RevokeRoleHint,
+		credential.NewRevokeRoleProcessor(),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.AnchorBatchHint,
+		credential.NewAnchorBatchProcessor(proposedAt),
+	); err != nil {
+		return pctx, err
+	} else if err := opr.SetProcessor(
+		credential.RevokeBatchHint,
+		credential.NewRevokeBatchProcessor(),
+	); err != nil {
+		return pctx, err
 	}
 
 	_ = set.Add(credential.CreateServiceHint, func(height base.Height) (base.OperationProcessor, error) {
@@ -98,6 +172,123 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 		)
 	})
 
+	_ = set.Add(credential.UpdateTemplateHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.UpdateTemplateStatusHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.UpdateCredentialHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.SuspendHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.ReinstateHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.UpdateHolderDIDHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.RenounceHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.ApproveAssignmentHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.GrantTemplateHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.GrantRoleHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.RevokeRoleHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.AnchorBatchHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
+	_ = set.Add(credential.RevokeBatchHint, func(height base.Height) (base.OperationProcessor, error) {
+		return opr.New(
+			height,
+			db.State,
+			nil,
+			nil,
+		)
+	})
+
 	var f currencycmds.ProposalOperationFactHintFunc = IsSupportedProposalOperationFactHintFunc
 
 	pctx = context.WithValue(pctx, currencycmds.OperationProcessorContextKey, opr)
@@ -106,3 +297,51 @@ func POperationProcessorsMap(pctx context.Context) (context.Context, error) {
 
 	return pctx, nil
 }
+
+// proposedAtFunc returns the proposed time of the proposal in processing at
+// the given height. The proposal is found by the last INIT voteproof, which
+// starts the processing of the proposal; when the voteproof is not for the
+// proposal, like after restart, the proposed time of the previous block is
+// used.
+func proposedAtFunc(
+	db isaac.Database, pool *isaacdatabase.TempPool, lvps *isaac.LastVoteproofsHandler,
+) credential.ProposedAtFunc {
+	return func(height base.Height) (time.Time, error) {
+		if t, found, err := proposedAtByVoteproof(pool, lvps, height); err != nil {
+			return time.Time{}, err
+		} else if found {
+			return t, nil
+		}
+
+		switch m, found, err := db.BlockMap(height - 1); {
+		case err != nil:
+			return time.Time{}, err
+		case !found:
+			return time.Time{}, errors.Errorf("proposal and previous block not found, %d", height)
+		default:
+			return m.Manifest().ProposedAt(), nil
+		}
+	}
+}
+
+func proposedAtByVoteproof(
+	pool *isaacdatabase.TempPool, lvps *isaac.LastVoteproofsHandler, height base.Height,
+) (time.Time, bool, error) {
+	ivp := lvps.Last().INIT()
+
+	switch {
+	case ivp == nil,
+		ivp.Point().Height() != height,
+		ivp.Result() != base.VoteResultMajority:
+		return time.Time{}, false, nil
+	}
+
+	switch pr, found, err := pool.Proposal(ivp.BallotMajority().Proposal()); {
+	case err != nil:
+		return time.Time{}, false, err
+	case !found:
+		return time.Time{}, false, nil
+	default:
+		return pr.ProposalFact().ProposedAt(), true, nil
+	}
+}
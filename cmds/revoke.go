@@ -18,6 +18,8 @@ type RevokeCredentialsCommand struct {
 	TemplateID string                      `arg:"" name:"template-id" help:"template id" required:"true"`
 	ID         string                      `arg:"" name:"id" help:"credential id" required:"true"`
 	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	ReasonCode string                      `name:"reason-code" help:"revocation reason code"`
+	Reason     string                      `name:"reason" help:"revocation reason"`
 	sender     base.Address
 	contract   base.Address
 	holder     base.Address
@@ -79,6 +81,8 @@ func (cmd *RevokeCredentialsCommand) createOperation() (base.Operation, error) {
 		cmd.holder,
 		cmd.TemplateID,
 		cmd.ID,
+		cmd.ReasonCode,
+		cmd.Reason,
 		cmd.Currency.CID,
 	)
 	if err := item.IsValid(nil); err != nil {
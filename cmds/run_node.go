@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"net/url"
 	"os"
 	"os/signal"
 	"syscall"
@@ -35,6 +36,7 @@ type RunCommand struct { //nolint:govet //...
 	Discovery []launch.ConnInfoFlag `help:"member discovery" placeholder:"ConnInfo"`
 	Hold      launch.HeightFlag     `help:"hold consensus states"`
 	HTTPState string                `name:"http-state" help:"runtime statistics thru https" placeholder:"bind address"`
+	PublicURL string                `name:"digest-public-url" help:"public base url of digest api published in credentials" placeholder:"url"`
 	exitf     func(error)
 	log       *zerolog.Logger
 	holded    bool
@@ -53,11 +55,18 @@ func (cmd *RunCommand) Run(pctx context.Context) error {
 		Interface("discovery", cmd.Discovery).
 		Interface("hold", cmd.Hold).
 		Interface("http_state", cmd.HTTPState).
+		Interface("digest_public_url", cmd.PublicURL).
 		Interface("dev", cmd.DevFlags).
 		Msg("flags")
 
 	cmd.log = log.Log()
 
+	if len(cmd.PublicURL) > 0 {
+		if u, err := url.Parse(cmd.PublicURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) < 1 {
+			return errors.Errorf("invalid --digest-public-url, %q", cmd.PublicURL)
+		}
+	}
+
 	if len(cmd.HTTPState) > 0 {
 		if err := cmd.runHTTPState(cmd.HTTPState); err != nil {
 			return errors.Wrap(err, "failed to run http state")
@@ -423,7 +432,8 @@ func (cmd *RunCommand) setDigestHandlers(
 		return nil, err
 	}
 
-	handlers := digest.NewHandlers(ctx, params.ISAAC.NetworkID(), encs, enc, st, cache, router, routes)
+	handlers := digest.NewHandlers(ctx, params.ISAAC.NetworkID(), encs, enc, st, cache, router, routes).
+		SetPublicURL(cmd.PublicURL)
 
 	return handlers, nil
 }
@@ -0,0 +1,92 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type SignDisclosuresCommand struct {
+	BaseCommand
+	Holder       currencycmds.AddressFlag   `arg:"" name:"holder" help:"credential holder" required:"true"`
+	Contract     currencycmds.AddressFlag   `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID   string                     `arg:"" name:"template-id" help:"template id" required:"true"`
+	CredentialID string                     `arg:"" name:"credential-id" help:"credential id" required:"true"`
+	Disclosures  string                     `arg:"" name:"disclosures" help:"json array of disclosures to present" required:"true"`
+	Challenge    string                     `arg:"" name:"challenge" help:"challenge of relying party" required:"true"`
+	HolderKeys   []string                   `name:"holder-privatekey" help:"privatekey of holder to sign disclosures" required:"true"`
+	NetworkID    currencycmds.NetworkIDFlag `name:"network-id" help:"network-id" required:"true" default:"${network_id}"`
+}
+
+type DisclosurePresentation struct {
+	Holder      string          `json:"holder"`
+	Disclosures []string        `json:"disclosures"`
+	Challenge   string          `json:"challenge"`
+	Signs       []base.BaseSign `json:"signs"`
+}
+
+func (cmd *SignDisclosuresCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.NetworkID.NetworkID().IsValid(nil); err != nil {
+		return err
+	}
+
+	holder, err := cmd.Holder.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid holder account format, %q", cmd.Holder.String())
+	}
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+
+	d := json.NewDecoder(bytes.NewReader([]byte(cmd.Disclosures)))
+	d.DisallowUnknownFields()
+
+	var disclosures []string
+	if err := d.Decode(&disclosures); err != nil {
+		return errors.Wrap(err, "disclosures not json array")
+	}
+
+	if len(disclosures) < 1 {
+		return errors.Errorf("empty disclosures")
+	}
+
+	ref := types.CredentialRef{Contract: contract.String(), TemplateID: cmd.TemplateID, ID: cmd.CredentialID}
+	b := types.DisclosureBytes(holder.String(), ref, disclosures, cmd.Challenge)
+
+	signs := make([]base.BaseSign, len(cmd.HolderKeys))
+	for i, s := range cmd.HolderKeys {
+		priv, err := base.DecodePrivatekeyFromString(s, enc)
+		if err != nil {
+			return errors.Wrap(err, "invalid holder privatekey")
+		}
+
+		sign, err := base.NewBaseSignFromBytes(priv, cmd.NetworkID.NetworkID(), b)
+		if err != nil {
+			return err
+		}
+		signs[i] = sign
+	}
+
+	PrettyPrint(cmd.Out, DisclosurePresentation{
+		Holder:      holder.String(),
+		Disclosures: disclosures,
+		Challenge:   cmd.Challenge,
+		Signs:       signs,
+	})
+
+	return nil
+}
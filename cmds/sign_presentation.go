@@ -0,0 +1,83 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type SignPresentationCommand struct {
+	BaseCommand
+	Holder      currencycmds.AddressFlag   `arg:"" name:"holder" help:"credential holder" required:"true"`
+	Credentials string                     `arg:"" name:"credentials" help:"json array of credentials; contract, template_id and id" required:"true"`
+	Challenge   string                     `arg:"" name:"challenge" help:"challenge of relying party" required:"true"`
+	HolderKeys  []string                   `name:"holder-privatekey" help:"privatekey of holder to sign presentation" required:"true"`
+	NetworkID   currencycmds.NetworkIDFlag `name:"network-id" help:"network-id" required:"true" default:"${network_id}"`
+}
+
+type Presentation struct {
+	Holder      string                `json:"holder"`
+	Credentials []types.CredentialRef `json:"credentials"`
+	Challenge   string                `json:"challenge"`
+	Signs       []base.BaseSign       `json:"signs"`
+}
+
+func (cmd *SignPresentationCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.NetworkID.NetworkID().IsValid(nil); err != nil {
+		return err
+	}
+
+	holder, err := cmd.Holder.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid holder account format, %q", cmd.Holder.String())
+	}
+
+	d := json.NewDecoder(bytes.NewReader([]byte(cmd.Credentials)))
+	d.DisallowUnknownFields()
+
+	var refs []types.CredentialRef
+	if err := d.Decode(&refs); err != nil {
+		return errors.Wrap(err, "credentials not json array")
+	}
+
+	if len(refs) < 1 {
+		return errors.Errorf("empty credentials")
+	}
+
+	b := types.PresentationBytes(holder.String(), refs, cmd.Challenge)
+
+	signs := make([]base.BaseSign, len(cmd.HolderKeys))
+	for i, s := range cmd.HolderKeys {
+		priv, err := base.DecodePrivatekeyFromString(s, enc)
+		if err != nil {
+			return errors.Wrap(err, "invalid holder privatekey")
+		}
+
+		sign, err := base.NewBaseSignFromBytes(priv, cmd.NetworkID.NetworkID(), b)
+		if err != nil {
+			return err
+		}
+		signs[i] = sign
+	}
+
+	PrettyPrint(cmd.Out, Presentation{
+		Holder:      holder.String(),
+		Credentials: refs,
+		Challenge:   cmd.Challenge,
+		Signs:       signs,
+	})
+
+	return nil
+}
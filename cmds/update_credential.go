@@ -0,0 +1,143 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
+)
+
+type UpdateCredentialCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender     currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract   currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract account address" required:"true"`
+	TemplateID string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	ID         string                      `arg:"" name:"id" help:"credential id" required:"true"`
+	Value      string                      `arg:"" name:"value" help:"credential value" required:"true"`
+	ValidFrom  uint64                      `arg:"" name:"valid-from" help:"valid from" required:"true"`
+	ValidUntil uint64                      `arg:"" name:"valid-until" help:"valid until" required:"true"`
+	Currency   currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	Salt       string                      `name:"salt" help:"salt to commit value of private value template; value is committed if given"`
+	IssuerKey  string                      `name:"issuer-privatekey" help:"privatekey of owner or operator of contract account to sign updated credential"`
+	Holder     string                      `name:"holder" help:"holder of credential; required to sign updated credential"`
+	DID        string                      `name:"did" help:"did of credential; required to sign updated credential"`
+	sender     base.Address
+	contract   base.Address
+	holder     base.Address
+	issuerKey  base.Privatekey
+	value      string
+}
+
+func (cmd *UpdateCredentialCommand) Run(pctx context.Context) error {
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateCredentialCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	if len(cmd.IssuerKey) > 0 {
+		priv, err := base.DecodePrivatekeyFromString(cmd.IssuerKey, enc)
+		if err != nil {
+			return errors.Wrap(err, "invalid issuer privatekey")
+		}
+		cmd.issuerKey = priv
+
+		if len(cmd.Holder) < 1 || len(cmd.DID) < 1 {
+			return errors.Errorf("holder and did required to sign updated credential")
+		}
+
+		holder, err := base.DecodeAddress(cmd.Holder, enc)
+		if err != nil {
+			return errors.Wrapf(err, "invalid holder format, %q", cmd.Holder)
+		}
+		cmd.holder = holder
+	}
+
+	value, err := commitValue(cmd.Value, cmd.Salt)
+	if err != nil {
+		return err
+	}
+	cmd.value = value
+
+	return nil
+}
+
+func (cmd *UpdateCredentialCommand) createOperation() (base.Operation, error) { // nolint:dupl
+	var items []credential.UpdateCredentialItem
+
+	var issuerSign *types.IssuerSign
+	if cmd.issuerKey != nil {
+		sign, err := types.NewIssuerSignFromCredential(
+			cmd.issuerKey, cmd.NetworkID.NetworkID(), cmd.contract,
+			types.NewCredential(cmd.holder, cmd.TemplateID, cmd.ID, cmd.value, cmd.ValidFrom, cmd.ValidUntil, cmd.DID),
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign credential")
+		}
+		issuerSign = &sign
+	}
+
+	item := credential.NewUpdateCredentialItem(
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.ID,
+		cmd.value,
+		cmd.ValidFrom,
+		cmd.ValidUntil,
+		issuerSign,
+		cmd.Currency.CID,
+	)
+	if err := item.IsValid(nil); err != nil {
+		return nil, err
+	}
+	items = append(items, item)
+
+	fact := credential.NewUpdateCredentialFact([]byte(cmd.Token), cmd.sender, items)
+
+	op, err := credential.NewUpdateCredential(fact)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update credential operation")
+	}
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to update credential operation")
+	}
+
+	return op, nil
+}
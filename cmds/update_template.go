@@ -0,0 +1,146 @@
+package cmds
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/operation/credential"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+type UpdateTemplateCommand struct {
+	BaseCommand
+	currencycmds.OperationFlags
+	Sender          currencycmds.AddressFlag    `arg:"" name:"sender" help:"sender address" required:"true"`
+	Contract        currencycmds.AddressFlag    `arg:"" name:"contract" help:"contract address of credential" required:"true"`
+	TemplateID      string                      `arg:"" name:"template-id" help:"template id" required:"true"`
+	TemplateName    string                      `arg:"" name:"template-name" help:"template name"  required:"true"`
+	ServiceDate     string                      `arg:"" name:"service-date" help:"service date; yyyy-MM-dd" required:"true"`
+	ExpirationDate  string                      `arg:"" name:"expiration-date" help:"expiration date; yyyy-MM-dd" required:"true"`
+	TemplateShare   bool                        `name:"template-share" help:"template share; true | false" required:"true"`
+	MultiAudit      bool                        `name:"multi-audit" help:"multi audit; true | false" required:"true"`
+	ConsentRequired bool                        `name:"consent-required" help:"require consent signature of holder to assign credential"`
+	Auditors        []currencycmds.AddressFlag  `name:"auditor" help:"auditor address of multi audit template"`
+	AuditThreshold  uint                        `name:"audit-threshold" help:"number of auditor approvals to activate credential"`
+	MaxSupply       uint64                      `name:"max-supply" help:"max number of credentials issued of template; no limit if 0"`
+	MaxPerHolder    uint64                      `name:"max-per-holder" help:"max number of credentials of template for each holder; no limit if 0"`
+	Schema          string                      `name:"schema" help:"json schema of credential value"`
+	DisplayName     string                      `arg:"" name:"display-name" help:"display name" required:"true"`
+	Description     string                      `arg:"" name:"description" help:"description"  required:"true"`
+	Currency        currencycmds.CurrencyIDFlag `arg:"" name:"currency-id" help:"currency id" required:"true"`
+	sender          base.Address
+	contract        base.Address
+	serviceDate     types.Date
+	expiration      types.Date
+	auditorSet      *types.AuditorSet
+	quota           *types.Quota
+	schema          *types.CredentialSchema
+}
+
+func (cmd *UpdateTemplateCommand) Run(pctx context.Context) error { // nolint:dupl
+	if _, err := cmd.prepare(pctx); err != nil {
+		return err
+	}
+
+	encs = cmd.Encoders
+	enc = cmd.Encoder
+
+	if err := cmd.parseFlags(); err != nil {
+		return err
+	}
+
+	op, err := cmd.createOperation()
+	if err != nil {
+		return err
+	}
+
+	PrettyPrint(cmd.Out, op)
+
+	return nil
+}
+
+func (cmd *UpdateTemplateCommand) parseFlags() error {
+	if err := cmd.OperationFlags.IsValid(nil); err != nil {
+		return err
+	}
+
+	sender, err := cmd.Sender.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid sender format, %q", cmd.Sender.String())
+	}
+	cmd.sender = sender
+
+	contract, err := cmd.Contract.Encode(enc)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+	cmd.contract = contract
+
+	serviceDate, expiration := types.Date(cmd.ServiceDate), types.Date(cmd.ExpirationDate)
+	if err := serviceDate.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid service date format, %q", cmd.ServiceDate)
+	}
+	if err := expiration.IsValid(nil); err != nil {
+		return errors.Wrapf(err, "invalid expiration date format, %q", cmd.ExpirationDate)
+	}
+	cmd.serviceDate = serviceDate
+	cmd.expiration = expiration
+
+	auditorSet, err := parseAuditorSet(cmd.Auditors, cmd.AuditThreshold)
+	if err != nil {
+		return err
+	}
+	cmd.auditorSet = auditorSet
+
+	quota, err := parseQuota(cmd.MaxSupply, cmd.MaxPerHolder)
+	if err != nil {
+		return err
+	}
+	cmd.quota = quota
+
+	schema, err := parseCredentialSchema(cmd.Schema)
+	if err != nil {
+		return err
+	}
+	cmd.schema = schema
+
+	return nil
+}
+
+func (cmd *UpdateTemplateCommand) createOperation() (base.Operation, error) { // nolint:dupl}
+	e := util.StringError("failed to create update-template operation")
+
+	fact := credential.NewUpdateTemplateFact(
+		[]byte(cmd.Token),
+		cmd.sender,
+		cmd.contract,
+		cmd.TemplateID,
+		cmd.TemplateName,
+		cmd.serviceDate,
+		cmd.expiration,
+		types.Bool(cmd.TemplateShare),
+		types.Bool(cmd.MultiAudit),
+		types.Bool(cmd.ConsentRequired),
+		cmd.auditorSet,
+		cmd.quota,
+		cmd.schema,
+		cmd.DisplayName,
+		cmd.Description,
+		cmd.Currency.CID,
+	)
+
+	op, err := credential.NewUpdateTemplate(fact)
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	err = op.Sign(cmd.Privatekey, cmd.NetworkID.NetworkID())
+	if err != nil {
+		return nil, e.Wrap(err)
+	}
+
+	return op, nil
+}
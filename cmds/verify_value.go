@@ -0,0 +1,67 @@
+package cmds
+
+import (
+	"context"
+	"os"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencycmds "github.com/ProtoconNet/mitum-currency/v3/cmds"
+	"github.com/pkg/errors"
+)
+
+type VerifyValueCommand struct {
+	BaseNetworkClientCommand
+	Contract   currencycmds.AddressFlag `arg:"" name:"contract" help:"contract account address" required:"true"`
+	TemplateID string                   `arg:"" name:"template-id" help:"template id" required:"true"`
+	ID         string                   `arg:"" name:"id" help:"credential id" required:"true"`
+	Value      string                   `arg:"" name:"value" help:"credential value presented by holder" required:"true"`
+	Salt       string                   `arg:"" name:"salt" help:"salt presented by holder" required:"true"`
+}
+
+type VerifyValueResult struct {
+	Verified bool   `json:"verified"`
+	Error    string `json:"error,omitempty"`
+}
+
+func (cmd *VerifyValueCommand) Run(pctx context.Context) error {
+	if err := cmd.Prepare(pctx); err != nil {
+		return err
+	}
+
+	defer func() {
+		_ = cmd.Client.Close()
+	}()
+
+	contract, err := cmd.Contract.Encode(cmd.Encoder)
+	if err != nil {
+		return errors.Wrapf(err, "invalid contract account format, %q", cmd.Contract.String())
+	}
+
+	st, err := cmd.templateState(pctx, contract, cmd.TemplateID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get template, %q", cmd.TemplateID)
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return err
+	}
+
+	st, err = cmd.state(pctx, state.StateKeyCredential(contract, cmd.TemplateID, cmd.ID))
+	if err != nil {
+		return errors.Wrapf(err, "failed to get credential, %q", cmd.ID)
+	}
+
+	credential, _, err := state.StateCredentialValue(st)
+	if err != nil {
+		return err
+	}
+
+	result := VerifyValueResult{Verified: true}
+	if err := types.VerifyCredentialValue(template, credential, cmd.Value, cmd.Salt); err != nil {
+		result = VerifyValueResult{Error: err.Error()}
+	}
+
+	return cmd.Print(result, os.Stdout)
+}
@@ -15,6 +15,7 @@ import (
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/fixedtree"
 	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -23,27 +24,35 @@ var bulkWriteLimit = 500
 
 type BlockSession struct {
 	sync.RWMutex
-	block                 mitumbase.BlockMap
-	ops                   []mitumbase.Operation
-	opstree               fixedtree.Tree
-	sts                   []mitumbase.State
-	st                    *currencydigest.Database
-	proposal              mitumbase.ProposalSignFact
-	opsTreeNodes          map[string]mitumbase.OperationFixedtreeNode
-	blockModels           []mongo.WriteModel
-	operationModels       []mongo.WriteModel
-	accountModels         []mongo.WriteModel
-	balanceModels         []mongo.WriteModel
-	currencyModels        []mongo.WriteModel
-	contractAccountModels []mongo.WriteModel
-	didIssuerModels       []mongo.WriteModel
-	didCredentialModels   []mongo.WriteModel
-	didHolderDIDModels    []mongo.WriteModel
-	didTemplateModels     []mongo.WriteModel
-	statesValue           *sync.Map
-	balanceAddressList    []string
-	credentialMap         map[string]struct{}
-	templateMap           map[string]struct{}
+	block                  mitumbase.BlockMap
+	ops                    []mitumbase.Operation
+	opstree                fixedtree.Tree
+	sts                    []mitumbase.State
+	st                     *currencydigest.Database
+	proposal               mitumbase.ProposalSignFact
+	opsTreeNodes           map[string]mitumbase.OperationFixedtreeNode
+	blockModels            []mongo.WriteModel
+	operationModels        []mongo.WriteModel
+	accountModels          []mongo.WriteModel
+	balanceModels          []mongo.WriteModel
+	currencyModels         []mongo.WriteModel
+	contractAccountModels  []mongo.WriteModel
+	didIssuerModels        []mongo.WriteModel
+	didCredentialModels    []mongo.WriteModel
+	didHolderDIDModels     []mongo.WriteModel
+	didTemplateModels      []mongo.WriteModel
+	didStatusListModels    []mongo.WriteModel
+	didPendingModels       []mongo.WriteModel
+	didTemplateGrantModels []mongo.WriteModel
+	didBatchAnchorModels   []mongo.WriteModel
+	statesValue            *sync.Map
+	balanceAddressList     []string
+	credentialMap          map[string]struct{}
+	templateMap            map[string]struct{}
+	updatedTemplates       []bson.M
+	updatedCredentials     []bson.M
+	updatedPendings        []bson.M
+	updatedStatusLists     []bson.M
 }
 
 func NewBlockSession(
@@ -165,6 +174,10 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 			}
 		}
 
+		if err := bs.unsetLatest(ctx, defaultColNameDIDCredential, bs.updatedCredentials); err != nil {
+			return err
+		}
+
 		if err := bs.writeModels(ctx, defaultColNameDIDCredential, bs.didCredentialModels); err != nil {
 			return err
 		}
@@ -177,11 +190,47 @@ func (bs *BlockSession) Commit(ctx context.Context) error {
 	}
 
 	if len(bs.didTemplateModels) > 0 {
+		if err := bs.unsetLatest(ctx, defaultColNameTemplate, bs.updatedTemplates); err != nil {
+			return err
+		}
+
 		if err := bs.writeModels(ctx, defaultColNameTemplate, bs.didTemplateModels); err != nil {
 			return err
 		}
 	}
 
+	if len(bs.didStatusListModels) > 0 {
+		if err := bs.unsetLatest(ctx, defaultColNameStatusList, bs.updatedStatusLists); err != nil {
+			return err
+		}
+
+		if err := bs.writeModels(ctx, defaultColNameStatusList, bs.didStatusListModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.didPendingModels) > 0 {
+		if err := bs.unsetLatest(ctx, defaultColNamePendingAssignment, bs.updatedPendings); err != nil {
+			return err
+		}
+
+		if err := bs.writeModels(ctx, defaultColNamePendingAssignment, bs.didPendingModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.didTemplateGrantModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameTemplateGrant, bs.didTemplateGrantModels); err != nil {
+			return err
+		}
+	}
+
+	if len(bs.didBatchAnchorModels) > 0 {
+		if err := bs.writeModels(ctx, defaultColNameBatchAnchor, bs.didBatchAnchorModels); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -403,6 +452,27 @@ func (bs *BlockSession) writeModelsChunk(ctx context.Context, col string, models
 	return nil
 }
 
+// unsetLatest keeps the previous versions of updated states as history; only
+// the latest versions are listed.
+func (bs *BlockSession) unsetLatest(ctx context.Context, col string, filters []bson.M) error {
+	for i := range filters {
+		filter := bson.M{"height": bson.M{"$lt": bs.block.Manifest().Height()}}
+		for k, v := range filters[i] {
+			filter[k] = v
+		}
+
+		if _, err := bs.st.DatabaseClient().Collection(col).UpdateMany(
+			ctx,
+			filter,
+			bson.M{"$set": bson.M{"latest": false}},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (bs *BlockSession) close() error {
 	bs.block = nil
 	bs.operationModels = nil
@@ -414,8 +484,16 @@ func (bs *BlockSession) close() error {
 	bs.didCredentialModels = nil
 	bs.didHolderDIDModels = nil
 	bs.didTemplateModels = nil
+	bs.didStatusListModels = nil
+	bs.didPendingModels = nil
+	bs.didTemplateGrantModels = nil
+	bs.didBatchAnchorModels = nil
 	bs.credentialMap = nil
 	bs.templateMap = nil
+	bs.updatedTemplates = nil
+	bs.updatedCredentials = nil
+	bs.updatedPendings = nil
+	bs.updatedStatusLists = nil
 
 	return bs.st.Close()
 }
@@ -1,9 +1,12 @@
 package digest
 
 import (
+	"strconv"
+
 	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
@@ -16,6 +19,10 @@ func (bs *BlockSession) prepareDID() error {
 	var didCredentialModels []mongo.WriteModel
 	var didHolderDIDModels []mongo.WriteModel
 	var didTemplateModels []mongo.WriteModel
+	var didStatusListModels []mongo.WriteModel
+	var didPendingModels []mongo.WriteModel
+	var didTemplateGrantModels []mongo.WriteModel
+	var didBatchAnchorModels []mongo.WriteModel
 
 	for i := range bs.sts {
 		st := bs.sts[i]
@@ -33,6 +40,15 @@ func (bs *BlockSession) prepareDID() error {
 			}
 			bs.credentialMap[cre.ID()] = struct{}{}
 			bs.templateMap[cre.TemplateID()] = struct{}{}
+			parsedKey, err := state.ParseStateKey(st.Key(), state.CredentialPrefix)
+			if err != nil {
+				return err
+			}
+			bs.updatedCredentials = append(bs.updatedCredentials, bson.M{
+				"contract":      parsedKey[1],
+				"template":      parsedKey[2],
+				"credential_id": parsedKey[3],
+			})
 			didCredentialModels = append(didCredentialModels, j...)
 
 		case state.IsStateHolderDIDKey(st.Key()):
@@ -46,7 +62,61 @@ func (bs *BlockSession) prepareDID() error {
 			if err != nil {
 				return err
 			}
+			parsedKey, err := state.ParseStateKey(st.Key(), state.CredentialPrefix)
+			if err != nil {
+				return err
+			}
+			bs.updatedTemplates = append(bs.updatedTemplates, bson.M{
+				"contract": parsedKey[1],
+				"template": parsedKey[2],
+			})
 			didTemplateModels = append(didTemplateModels, j...)
+		case state.IsStateStatusListKey(st.Key()):
+			j, err := bs.handleStatusListState(st)
+			if err != nil {
+				return err
+			}
+			parsedKey, err := state.ParseStateKey(st.Key(), state.CredentialPrefix)
+			if err != nil {
+				return err
+			}
+			chunk, err := strconv.ParseUint(parsedKey[3], 10, 64)
+			if err != nil {
+				return err
+			}
+			bs.updatedStatusLists = append(bs.updatedStatusLists, bson.M{
+				"contract": parsedKey[1],
+				"template": parsedKey[2],
+				"chunk":    int64(chunk),
+			})
+			didStatusListModels = append(didStatusListModels, j...)
+		case state.IsStatePendingAssignmentKey(st.Key()):
+			j, err := bs.handlePendingAssignmentState(st)
+			if err != nil {
+				return err
+			}
+			parsedKey, err := state.ParseStateKey(st.Key(), state.CredentialPrefix)
+			if err != nil {
+				return err
+			}
+			bs.updatedPendings = append(bs.updatedPendings, bson.M{
+				"contract":      parsedKey[1],
+				"template":      parsedKey[2],
+				"credential_id": parsedKey[3],
+			})
+			didPendingModels = append(didPendingModels, j...)
+		case state.IsStateTemplateGrantKey(st.Key()):
+			j, err := bs.handleTemplateGrantState(st)
+			if err != nil {
+				return err
+			}
+			didTemplateGrantModels = append(didTemplateGrantModels, j...)
+		case state.IsStateBatchAnchorKey(st.Key()):
+			j, err := bs.handleBatchAnchorState(st)
+			if err != nil {
+				return err
+			}
+			didBatchAnchorModels = append(didBatchAnchorModels, j...)
 		default:
 			continue
 		}
@@ -56,6 +126,10 @@ func (bs *BlockSession) prepareDID() error {
 	bs.didCredentialModels = didCredentialModels
 	bs.didHolderDIDModels = didHolderDIDModels
 	bs.didTemplateModels = didTemplateModels
+	bs.didStatusListModels = didStatusListModels
+	bs.didPendingModels = didPendingModels
+	bs.didTemplateGrantModels = didTemplateGrantModels
+	bs.didBatchAnchorModels = didBatchAnchorModels
 
 	return nil
 }
@@ -99,3 +173,43 @@ func (bs *BlockSession) handleTemplateState(st mitumbase.State) ([]mongo.WriteMo
 		}, nil
 	}
 }
+
+func (bs *BlockSession) handleStatusListState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if statusListDoc, err := NewStatusListDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(statusListDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handlePendingAssignmentState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if pendingDoc, err := NewPendingAssignmentDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(pendingDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleTemplateGrantState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if grantDoc, err := NewTemplateGrantDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(grantDoc),
+		}, nil
+	}
+}
+
+func (bs *BlockSession) handleBatchAnchorState(st mitumbase.State) ([]mongo.WriteModel, error) {
+	if batchDoc, err := NewBatchAnchorDoc(st, bs.st.DatabaseEncoder()); err != nil {
+		return nil, err
+	} else {
+		return []mongo.WriteModel{
+			mongo.NewInsertOneModel().SetDocument(batchDoc),
+		}, nil
+	}
+}
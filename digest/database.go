@@ -7,6 +7,7 @@ import (
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
 	"github.com/ProtoconNet/mitum-currency/v3/digest/util"
 	mitumbase "github.com/ProtoconNet/mitum2/base"
+	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
@@ -23,6 +24,10 @@ var (
 	defaultColNameDIDCredential        = "digest_did_credential"
 	defaultColNameHolder               = "digest_did_holder_did"
 	defaultColNameTemplate             = "digest_did_template"
+	defaultColNameStatusList           = "digest_did_status_list"
+	defaultColNamePendingAssignment    = "digest_did_pending_assignment"
+	defaultColNameTemplateGrant        = "digest_did_template_grant"
+	defaultColNameBatchAnchor          = "digest_did_batch_anchor"
 )
 
 var maxLimit int64 = 50
@@ -58,13 +63,20 @@ func CredentialService(st *currencydigest.Database, contract string) (*types.Des
 	return design, nil
 }
 
-func Credential(st *currencydigest.Database, contract, templateID, credentialID string) (*types.Credential, bool, error) {
+// CredentialFilter narrows credential listings by the recorded status and
+// revocation of credentials; empty fields are not applied.
+type CredentialFilter struct {
+	Status     string
+	ReasonCode string
+	Revoker    string
+}
+
+func Credential(st *currencydigest.Database, contract, templateID, credentialID string) (*state.CredentialStateValue, error) {
 	filter := util.NewBSONFilter("contract", contract)
 	filter = filter.Add("template", templateID)
 	filter = filter.Add("credential_id", credentialID)
 
-	var credential *types.Credential
-	var isActive bool
+	var credential *state.CredentialStateValue
 	var sta mitumbase.State
 	var err error
 	if err = st.DatabaseClient().GetByFilter(
@@ -75,27 +87,83 @@ func Credential(st *currencydigest.Database, contract, templateID, credentialID
 			if err != nil {
 				return err
 			}
-			cre, active, err := state.StateCredentialValue(sta)
+			cv, err := state.StateCredentialStateValue(sta)
 			if err != nil {
 				return err
 			}
-			credential = &cre
-			isActive = active
+			credential = &cv
 			return nil
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
 	); err != nil {
-		return nil, false, err
+		return nil, err
+	}
+
+	return credential, nil
+}
+
+func CredentialHistory(
+	st *currencydigest.Database,
+	contract, templateID, credentialID string,
+	reverse bool,
+	offset *mitumbase.Height,
+	limit int64,
+	callback func(state.CredentialStateValue, mitumbase.State) (bool, error),
+) error {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+	filter = filter.Add("credential_id", credentialID)
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	if offset != nil {
+		if reverse {
+			filter = filter.Add("height", bson.M{"$lt": *offset})
+		} else {
+			filter = filter.Add("height", bson.M{"$gt": *offset})
+		}
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("height", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
 	}
 
-	return credential, isActive, nil
+	return st.DatabaseClient().Find(
+		context.Background(),
+		defaultColNameDIDCredential,
+		filter.D(),
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := currencydigest.LoadState(cursor.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return false, err
+			}
+			cv, err := state.StateCredentialStateValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(cv, st)
+		},
+		opt,
+	)
 }
 
-func Template(st *currencydigest.Database, contract, templateID string) (*types.Template, error) {
+func Template(st *currencydigest.Database, contract, templateID string) (*types.Template, types.TemplateStatus, error) {
 	filter := util.NewBSONFilter("contract", contract)
 	filter = filter.Add("template", templateID)
 
 	var template *types.Template
+	var status types.TemplateStatus
 	var sta mitumbase.State
 	var err error
 	if err = st.DatabaseClient().GetByFilter(
@@ -111,6 +179,41 @@ func Template(st *currencydigest.Database, contract, templateID string) (*types.
 				return err
 			}
 			template = &te
+			status, err = state.StateTemplateStatusValue(sta)
+			if err != nil {
+				return err
+			}
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, "", err
+	}
+
+	return template, status, nil
+}
+
+// TemplateGrant returns the owner service of the template granted to the
+// contract.
+func TemplateGrant(st *currencydigest.Database, contract, templateID string) (mitumbase.Address, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+
+	var owner mitumbase.Address
+	var sta mitumbase.State
+	var err error
+	if err = st.DatabaseClient().GetByFilter(
+		defaultColNameTemplateGrant,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			owner, err = state.StateTemplateGrantValue(sta)
+			if err != nil {
+				return err
+			}
 			return nil
 		},
 		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
@@ -118,7 +221,126 @@ func Template(st *currencydigest.Database, contract, templateID string) (*types.
 		return nil, err
 	}
 
-	return template, nil
+	return owner, nil
+}
+
+func BatchAnchor(st *currencydigest.Database, contract, templateID, batchID string) (*state.BatchAnchorStateValue, mitumbase.Height, error) {
+	filter := util.NewBSONFilter("contract", contract)
+	filter = filter.Add("template", templateID)
+	filter = filter.Add("batch_id", batchID)
+
+	var batch *state.BatchAnchorStateValue
+	var height mitumbase.Height
+	var sta mitumbase.State
+	var err error
+	if err = st.DatabaseClient().GetByFilter(
+		defaultColNameBatchAnchor,
+		filter.D(),
+		func(res *mongo.SingleResult) error {
+			sta, err = currencydigest.LoadState(res.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return err
+			}
+			ba, err := state.StateBatchAnchorValue(sta)
+			if err != nil {
+				return err
+			}
+			batch = &ba
+			height = sta.Height()
+
+			return nil
+		},
+		options.FindOne().SetSort(util.NewBSONFilter("height", -1).D()),
+	); err != nil {
+		return nil, 0, err
+	}
+
+	return batch, height, nil
+}
+
+// ServiceTemplate returns the template used by the credentials of the
+// contract; the granted template is read from the owner service.
+func ServiceTemplate(st *currencydigest.Database, contract, templateID string) (*types.Template, types.TemplateStatus, error) {
+	template, status, err := Template(st, contract, templateID)
+	if err == nil && template != nil {
+		return template, status, nil
+	}
+
+	owner, gerr := TemplateGrant(st, contract, templateID)
+	if gerr != nil || owner == nil {
+		return template, status, err
+	}
+
+	return Template(st, owner.String(), templateID)
+}
+
+// TemplateOwner returns the credential service which owns the template used by
+// the contract; the counts and the status list of the granted template are
+// kept by the owner.
+func TemplateOwner(st *currencydigest.Database, contract, templateID string) string {
+	owner, err := TemplateGrant(st, contract, templateID)
+	if err != nil || owner == nil {
+		return contract
+	}
+
+	return owner.String()
+}
+
+// StatusList returns the status list of the template assembled from the latest
+// versions of its chunks.
+func StatusList(st *currencydigest.Database, contract, templateID string) (*types.StatusList, mitumbase.Height, error) {
+	filter := bson.D{{Key: "$and", Value: bson.A{
+		bson.D{{Key: "contract", Value: contract}},
+		bson.D{{Key: "template", Value: templateID}},
+		bson.D{{Key: "latest", Value: bson.D{{Key: "$ne", Value: false}}}},
+	}}}
+
+	var chunks []types.StatusList
+	height := mitumbase.NilHeight
+
+	if err := st.DatabaseClient().Find(
+		context.Background(),
+		defaultColNameStatusList,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			sta, err := currencydigest.LoadState(cursor.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return false, err
+			}
+			sl, err := state.StateStatusListValue(sta)
+			if err != nil {
+				return false, err
+			}
+			chunks = append(chunks, sl)
+			if sta.Height() > height {
+				height = sta.Height()
+			}
+			return true, nil
+		},
+		options.Find().SetSort(util.NewBSONFilter("chunk", 1).D()),
+	); err != nil {
+		return nil, mitumbase.NilHeight, err
+	}
+
+	if len(chunks) < 1 {
+		return nil, mitumbase.NilHeight, nil
+	}
+
+	var size uint64
+	var bitstring []byte
+
+	for i := range chunks {
+		if i < len(chunks)-1 && chunks[i].Size() != types.StatusListChunkBits {
+			return nil, mitumbase.NilHeight, errors.Errorf("status list chunk not filled, %s, %s; %d", contract, templateID, i)
+		}
+
+		size += chunks[i].Size()
+		bitstring = append(bitstring, chunks[i].Bitstring()...)
+	}
+
+	statusList := types.NewStatusList(size, bitstring)
+
+	return &statusList, height, nil
 }
 
 func HolderDID(st *currencydigest.Database, contract, holder string) (string, error) {
@@ -155,12 +377,13 @@ func CredentialsByServiceTemplate(
 	st *currencydigest.Database,
 	contract,
 	templateID string,
+	credentialFilter CredentialFilter,
 	reverse bool,
 	offset string,
 	limit int64,
-	callback func(types.Credential, bool, mitumbase.State) (bool, error),
+	callback func(state.CredentialStateValue, mitumbase.State) (bool, error),
 ) error {
-	filter, err := buildCredentialFilterByServiceTemplate(contract, templateID, offset, reverse)
+	filter, err := buildCredentialFilterByServiceTemplate(contract, templateID, credentialFilter, offset, reverse)
 	if err != nil {
 		return err
 	}
@@ -191,24 +414,41 @@ func CredentialsByServiceTemplate(
 			if err != nil {
 				return false, err
 			}
-			credential, isActive, err := state.StateCredentialValue(st)
+			cv, err := state.StateCredentialStateValue(st)
 			if err != nil {
 				return false, err
 			}
-			return callback(credential, isActive, st)
+			return callback(cv, st)
 		},
 		opt,
 	)
 }
 
-func buildCredentialFilterByServiceTemplate(contract, templateID string, offset string, reverse bool) (bson.D, error) {
+func buildCredentialFilterByServiceTemplate(
+	contract, templateID string,
+	credentialFilter CredentialFilter,
+	offset string,
+	reverse bool,
+) (bson.D, error) {
 	filterA := bson.A{}
 
 	// filter fot matching collection
 	filterContract := bson.D{{"contract", bson.D{{"$in", []string{contract}}}}}
 	filterTemplate := bson.D{{"template", bson.D{{"$in", []string{templateID}}}}}
+	filterLatest := bson.D{{Key: "latest", Value: bson.D{{Key: "$ne", Value: false}}}}
 	filterA = append(filterA, filterContract)
 	filterA = append(filterA, filterTemplate)
+	filterA = append(filterA, filterLatest)
+
+	if len(credentialFilter.Status) > 0 {
+		filterA = append(filterA, bson.D{{Key: "status", Value: credentialFilter.Status}})
+	}
+	if len(credentialFilter.ReasonCode) > 0 {
+		filterA = append(filterA, bson.D{{Key: "revocation_reason_code", Value: credentialFilter.ReasonCode}})
+	}
+	if len(credentialFilter.Revoker) > 0 {
+		filterA = append(filterA, bson.D{{Key: "revoker", Value: credentialFilter.Revoker}})
+	}
 
 	// if offset exist, apply offset
 	if len(offset) > 0 {
@@ -235,10 +475,70 @@ func buildCredentialFilterByServiceTemplate(contract, templateID string, offset
 	return filter, nil
 }
 
+// PendingAssignmentsByServiceTemplate returns the assignments of the
+// multi-audit template still waiting for the approvals of auditors.
+func PendingAssignmentsByServiceTemplate(
+	st *currencydigest.Database,
+	contract,
+	templateID string,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(state.PendingAssignmentStateValue, mitumbase.State) (bool, error),
+) error {
+	filterA := bson.A{
+		bson.D{{Key: "contract", Value: contract}},
+		bson.D{{Key: "template", Value: templateID}},
+		bson.D{{Key: "latest", Value: bson.D{{Key: "$ne", Value: false}}}},
+		bson.D{{Key: "approved", Value: false}},
+	}
+
+	sr := 1
+	op := "$gt"
+	if reverse {
+		sr = -1
+		op = "$lt"
+	}
+
+	if len(offset) > 0 {
+		filterA = append(filterA, bson.D{{Key: "credential_id", Value: bson.D{{Key: op, Value: offset}}}})
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("credential_id", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.DatabaseClient().Find(
+		context.Background(),
+		defaultColNamePendingAssignment,
+		bson.D{{Key: "$and", Value: filterA}},
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := currencydigest.LoadState(cursor.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return false, err
+			}
+			pa, err := state.StatePendingAssignmentValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(pa, st)
+		},
+		opt,
+	)
+}
+
 func CredentialsByServiceHolder(
 	st *currencydigest.Database,
 	contract, holder string,
-	callback func(types.Credential, bool, mitumbase.State) (bool, error),
+	callback func(state.CredentialStateValue, mitumbase.State) (bool, error),
 ) error {
 	filter, err := buildCredentialFilterByServiceHolder(contract, holder)
 	if err != nil {
@@ -260,11 +560,11 @@ func CredentialsByServiceHolder(
 			if err != nil {
 				return false, err
 			}
-			credential, isActive, err := state.StateCredentialValue(st)
+			cv, err := state.StateCredentialStateValue(st)
 			if err != nil {
 				return false, err
 			}
-			return callback(credential, isActive, st)
+			return callback(cv, st)
 		},
 		opt,
 	)
@@ -276,8 +576,10 @@ func buildCredentialFilterByServiceHolder(contract, holder string) (bson.D, erro
 	// filter fot matching collection
 	filterContract := bson.D{{"contract", bson.D{{"$in", []string{contract}}}}}
 	filterHolder := bson.D{{"d.value.credential.holder", holder}}
+	filterLatest := bson.D{{Key: "latest", Value: bson.D{{Key: "$ne", Value: false}}}}
 	filterA = append(filterA, filterContract)
 	filterA = append(filterA, filterHolder)
+	filterA = append(filterA, filterLatest)
 
 	filter := bson.D{}
 	if len(filterA) > 0 {
@@ -288,3 +590,86 @@ func buildCredentialFilterByServiceHolder(contract, holder string) (bson.D, erro
 
 	return filter, nil
 }
+
+func TemplatesByService(
+	st *currencydigest.Database,
+	contract string,
+	reverse bool,
+	offset string,
+	limit int64,
+	callback func(types.Template, mitumbase.State) (bool, error),
+) error {
+	filter, err := buildTemplateFilterByService(contract, offset, reverse)
+	if err != nil {
+		return err
+	}
+
+	sr := 1
+	if reverse {
+		sr = -1
+	}
+
+	opt := options.Find().SetSort(
+		util.NewBSONFilter("template", sr).D(),
+	)
+
+	switch {
+	case limit <= 0: // no limit
+	case limit > maxLimit:
+		opt = opt.SetLimit(maxLimit)
+	default:
+		opt = opt.SetLimit(limit)
+	}
+
+	return st.DatabaseClient().Find(
+		context.Background(),
+		defaultColNameTemplate,
+		filter,
+		func(cursor *mongo.Cursor) (bool, error) {
+			st, err := currencydigest.LoadState(cursor.Decode, st.DatabaseEncoders())
+			if err != nil {
+				return false, err
+			}
+			template, err := state.StateTemplateValue(st)
+			if err != nil {
+				return false, err
+			}
+			return callback(template, st)
+		},
+		opt,
+	)
+}
+
+func buildTemplateFilterByService(contract string, offset string, reverse bool) (bson.D, error) {
+	filterA := bson.A{}
+
+	// filter fot matching collection
+	filterContract := bson.D{{Key: "contract", Value: bson.D{{Key: "$in", Value: []string{contract}}}}}
+	filterLatest := bson.D{{Key: "latest", Value: bson.D{{Key: "$ne", Value: false}}}}
+	filterA = append(filterA, filterContract)
+	filterA = append(filterA, filterLatest)
+
+	// if offset exist, apply offset
+	if len(offset) > 0 {
+		if !reverse {
+			filterOffset := bson.D{
+				{Key: "template", Value: bson.D{{Key: "$gt", Value: offset}}},
+			}
+			filterA = append(filterA, filterOffset)
+		} else {
+			filterOffset := bson.D{
+				{Key: "template", Value: bson.D{{Key: "$lt", Value: offset}}},
+			}
+			filterA = append(filterA, filterOffset)
+		}
+	}
+
+	filter := bson.D{}
+	if len(filterA) > 0 {
+		filter = bson.D{
+			{Key: "$and", Value: filterA},
+		}
+	}
+
+	return filter, nil
+}
@@ -1,6 +1,8 @@
 package digest
 
 import (
+	"strconv"
+
 	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
 	mongodbstorage "github.com/ProtoconNet/mitum-currency/v3/digest/mongodb"
@@ -93,11 +95,25 @@ type CredentialDoc struct {
 	mongodbstorage.BaseDoc
 	st         base.State
 	credential types.Credential
-	isActive   bool
+	status     types.CredentialStatus
+	version    uint64
+	revocation *types.Revocation
 }
 
 func NewCredentialDoc(st base.State, enc encoder.Encoder) (*CredentialDoc, error) {
-	credential, isActive, err := state.StateCredentialValue(st)
+	credential, _, err := state.StateCredentialValue(st)
+	if err != nil {
+		return nil, err
+	}
+	status, err := state.StateCredentialStatusValue(st)
+	if err != nil {
+		return nil, err
+	}
+	version, err := state.StateCredentialVersionValue(st)
+	if err != nil {
+		return nil, err
+	}
+	revocation, err := state.StateCredentialRevocationValue(st)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +126,9 @@ func NewCredentialDoc(st base.State, enc encoder.Encoder) (*CredentialDoc, error
 		BaseDoc:    b,
 		st:         st,
 		credential: credential,
-		isActive:   isActive,
+		status:     status,
+		version:    version,
+		revocation: revocation,
 	}, nil
 }
 
@@ -127,7 +145,15 @@ func (doc CredentialDoc) MarshalBSON() ([]byte, error) {
 	m["contract"] = parsedKey[1]
 	m["template"] = parsedKey[2]
 	m["credential_id"] = parsedKey[3]
-	m["is_active"] = doc.isActive
+	m["status"] = doc.status
+	m["is_active"] = doc.status == types.CredentialStatusActive
+	m["version"] = doc.version
+	if doc.revocation != nil {
+		m["revocation_reason_code"] = doc.revocation.ReasonCode()
+		m["revoker"] = doc.revocation.Revoker().String()
+		m["revoked_height"] = doc.revocation.Height()
+	}
+	m["latest"] = true
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
@@ -169,6 +195,174 @@ func (doc TemplateDoc) MarshalBSON() ([]byte, error) {
 
 	m["contract"] = parsedKey[1]
 	m["template"] = parsedKey[2]
+	m["latest"] = true
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type StatusListDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+}
+
+func NewStatusListDoc(st base.State, enc encoder.Encoder) (*StatusListDoc, error) {
+	if _, err := state.StateStatusListValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StatusListDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc StatusListDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), state.CredentialPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	chunk, err := strconv.ParseUint(parsedKey[3], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["chunk"] = int64(chunk)
+	m["latest"] = true
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type TemplateGrantDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+}
+
+func NewTemplateGrantDoc(st base.State, enc encoder.Encoder) (*TemplateGrantDoc, error) {
+	if _, err := state.StateTemplateGrantValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateGrantDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc TemplateGrantDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), state.CredentialPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type BatchAnchorDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+}
+
+func NewBatchAnchorDoc(st base.State, enc encoder.Encoder) (*BatchAnchorDoc, error) {
+	if _, err := state.StateBatchAnchorValue(st); err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BatchAnchorDoc{
+		BaseDoc: b,
+		st:      st,
+	}, nil
+}
+
+func (doc BatchAnchorDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), state.CredentialPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["batch_id"] = parsedKey[3]
+	m["height"] = doc.st.Height()
+
+	return bsonenc.Marshal(m)
+}
+
+type PendingAssignmentDoc struct {
+	mongodbstorage.BaseDoc
+	st base.State
+	pa state.PendingAssignmentStateValue
+}
+
+func NewPendingAssignmentDoc(st base.State, enc encoder.Encoder) (*PendingAssignmentDoc, error) {
+	pa, err := state.StatePendingAssignmentValue(st)
+	if err != nil {
+		return nil, err
+	}
+	b, err := mongodbstorage.NewBaseDoc(nil, st, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PendingAssignmentDoc{
+		BaseDoc: b,
+		st:      st,
+		pa:      pa,
+	}, nil
+}
+
+func (doc PendingAssignmentDoc) MarshalBSON() ([]byte, error) {
+	m, err := doc.BaseDoc.M()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedKey, err := state.ParseStateKey(doc.st.Key(), state.CredentialPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	m["contract"] = parsedKey[1]
+	m["template"] = parsedKey[2]
+	m["credential_id"] = parsedKey[3]
+	m["holder"] = doc.pa.Credential.Holder().String()
+	m["approved"] = doc.pa.IsApproved()
+	m["latest"] = true
 	m["height"] = doc.st.Height()
 
 	return bsonenc.Marshal(m)
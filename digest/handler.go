@@ -4,6 +4,7 @@ import (
 	"context"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/ProtoconNet/mitum-currency/v3/digest/network"
@@ -19,11 +20,20 @@ import (
 )
 
 var (
-	HandlerPathDIDService     = `/did/{contract:.+}/service`
-	HandlerPathDIDCredential  = `/did/{contract:.+}/template/{templateid:.+}/credential/{credentialid:.+}`
-	HandlerPathDIDTemplate    = `/did/{contract:.+}/template/{templateid:.+}`
-	HandlerPathDIDCredentials = `/did/{contract:.+}/template/{templateid:.+}/credentials`
-	HandlerPathDIDHolder      = `/did/{contract:.+}/holder/{holder:(?i)` + base.REStringAddressString + `}` // revive:disable-line:line-length-limit
+	HandlerPathDIDResolve                     = `/did/resolve/{did:.+}`
+	HandlerPathDIDVerifyPresentation          = `/did/verify/presentation`
+	HandlerPathDIDService                     = `/did/{contract:.+}/service`
+	HandlerPathDIDCredential                  = `/did/{contract:.+}/template/{templateid:.+}/credential/{credentialid:.+}`
+	HandlerPathDIDCredentialHistory           = `/did/{contract:.+}/template/{templateid:.+}/credential/{credentialid:.+}/history`
+	HandlerPathDIDCredentialVC                = `/did/{contract:.+}/template/{templateid:.+}/credential/{credentialid:.+}/vc`
+	HandlerPathDIDCredentialVerifyValue       = `/did/{contract:.+}/template/{templateid:.+}/credential/{credentialid:.+}/verify-value`
+	HandlerPathDIDCredentialVerifyDisclosures = `/did/{contract:.+}/template/{templateid:.+}/credential/{credentialid:.+}/verify-disclosures`
+	HandlerPathDIDBatchVerify                 = `/did/{contract:.+}/template/{templateid:.+}/batch/{batchid:.+}/verify`
+	HandlerPathDIDTemplate                    = `/did/{contract:.+}/template/{templateid:.+}`
+	HandlerPathDIDCredentials                 = `/did/{contract:.+}/template/{templateid:.+}/credentials`
+	HandlerPathDIDStatusList                  = `/did/{contract:.+}/template/{templateid:.+}/status-list`
+	HandlerPathDIDPendingAssignments          = `/did/{contract:.+}/template/{templateid:.+}/pending-assignments`
+	HandlerPathDIDHolder                      = `/did/{contract:.+}/holder/{holder:(?i)` + base.REStringAddressString + `}` // revive:disable-line:line-length-limit
 )
 
 func init() {
@@ -48,6 +58,7 @@ type Handlers struct {
 	itemsLimiter    func(string /* request type */) int64
 	rg              *singleflight.Group
 	expireNotFilled time.Duration
+	publicURL       string
 }
 
 func NewHandlers(
@@ -100,6 +111,15 @@ func (hd *Handlers) SetLimiter(f func(string) int64) *Handlers {
 	return hd
 }
 
+// SetPublicURL sets the base URL of the API which is published in the
+// credentials, like the status list URL; the URL is not taken from the
+// requests, which are controlled by the clients.
+func (hd *Handlers) SetPublicURL(u string) *Handlers {
+	hd.publicURL = strings.TrimRight(u, "/")
+
+	return hd
+}
+
 func (hd *Handlers) Cache() currencydigest.Cache {
 	return hd.cache
 }
@@ -113,10 +133,28 @@ func (hd *Handlers) Handler() http.Handler {
 }
 
 func (hd *Handlers) setHandlers() {
+	_ = hd.setHandler(HandlerPathDIDResolve, hd.handleDIDResolve, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDVerifyPresentation, hd.handleVerifyPresentation, false).
+		Methods(http.MethodOptions, "POST")
 	_ = hd.setHandler(HandlerPathDIDService, hd.handleCredentialService, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDIDCredentials, hd.handleCredentials, true).
 		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDStatusList, hd.handleStatusList, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDPendingAssignments, hd.handlePendingAssignments, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDCredentialHistory, hd.handleCredentialHistory, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDCredentialVC, hd.handleCredentialVC, true).
+		Methods(http.MethodOptions, "GET")
+	_ = hd.setHandler(HandlerPathDIDCredentialVerifyValue, hd.handleCredentialVerifyValue, false).
+		Methods(http.MethodOptions, "POST")
+	_ = hd.setHandler(HandlerPathDIDCredentialVerifyDisclosures, hd.handleCredentialVerifyDisclosures, false).
+		Methods(http.MethodOptions, "POST")
+	_ = hd.setHandler(HandlerPathDIDBatchVerify, hd.handleBatchVerify, false).
+		Methods(http.MethodOptions, "POST")
 	_ = hd.setHandler(HandlerPathDIDCredential, hd.handleCredential, true).
 		Methods(http.MethodOptions, "GET")
 	_ = hd.setHandler(HandlerPathDIDHolder, hd.handleHolderCredential, true).
@@ -1,11 +1,16 @@
 package digest
 
 import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
 	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
 	mitumutil "github.com/ProtoconNet/mitum2/util"
 	"github.com/gorilla/mux"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -14,7 +19,14 @@ import (
 )
 
 func (hd *Handlers) handleCredentialService(w http.ResponseWriter, r *http.Request) {
-	cacheKey := currencydigest.CacheKeyPath(r)
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cacheKey := currencydigest.CacheKey(
+		r.URL.Path, currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
 	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
 		return
 	}
@@ -27,7 +39,7 @@ func (hd *Handlers) handleCredentialService(w http.ResponseWriter, r *http.Reque
 	}
 
 	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
-		return hd.handleCredentialServiceInGroup(contract)
+		return hd.handleCredentialServiceInGroup(contract, offset, reverse, limit)
 	}); err != nil {
 		currencydigest.HTTP2HandleError(w, err)
 	} else {
@@ -38,28 +50,103 @@ func (hd *Handlers) handleCredentialService(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-func (hd *Handlers) handleCredentialServiceInGroup(contract string) (interface{}, error) {
-	switch design, err := CredentialService(hd.database, contract); {
+func (hd *Handlers) handleCredentialServiceInGroup(
+	contract string,
+	offset string,
+	reverse bool,
+	l int64,
+) (interface{}, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("service-templates")
+	} else {
+		limit = l
+	}
+
+	var design types.Design
+	switch de, err := CredentialService(hd.database, contract); {
 	case err != nil:
 		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential service, contract %s", contract)
-	case design == nil:
+	case de == nil:
 		return nil, mitumutil.ErrNotFound.Errorf("credential service, contract %s", contract)
 	default:
-		hal, err := hd.buildCredentialServiceHal(contract, *design)
-		if err != nil {
-			return nil, err
-		}
-		return hd.encoder.Marshal(hal)
+		design = *de
+	}
+
+	var vas []currencydigest.Hal
+	if err := TemplatesByService(
+		hd.database, contract, reverse, offset, limit,
+		func(template types.Template, st base.State) (bool, error) {
+			status, err := state.StateTemplateStatusValue(st)
+			if err != nil {
+				return false, err
+			}
+
+			hal, err := hd.buildTemplateHal(contract, template.TemplateID(), template, status)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal)
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "templates by contract %s", contract)
+	}
+
+	hal, err := hd.buildCredentialServiceHal(contract, design, vas, offset, reverse)
+	if err != nil {
+		return nil, err
 	}
+	return hd.encoder.Marshal(hal)
 }
 
-func (hd *Handlers) buildCredentialServiceHal(contract string, design types.Design) (currencydigest.Hal, error) {
-	h, err := hd.combineURL(HandlerPathDIDService, "contract", contract)
+func (hd *Handlers) buildCredentialServiceHal(
+	contract string,
+	design types.Design,
+	vas []currencydigest.Hal,
+	offset string,
+	reverse bool,
+) (currencydigest.Hal, error) {
+	baseSelf, err := hd.combineURL(HandlerPathDIDService, "contract", contract)
 	if err != nil {
 		return nil, err
 	}
 
-	hal := currencydigest.NewBaseHal(design, currencydigest.NewHalLink(h, nil))
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(design, currencydigest.NewHalLink(self, nil))
+	hal = hal.AddExtras("templates", vas)
+
+	var nextOffset string
+
+	if len(vas) > 0 {
+		va, ok := vas[len(vas)-1].Interface().(types.Template)
+		if !ok {
+			return nil, errors.Errorf("failed to build credential service hal")
+		}
+		nextOffset = va.TemplateID()
+	}
+
+	if len(nextOffset) > 0 {
+		next := baseSelf
+		next = currencydigest.AddQueryValue(next, currencydigest.StringOffsetQuery(nextOffset))
+
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", currencydigest.NewHalLink(currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)), nil))
 
 	return hal, nil
 }
@@ -101,13 +188,13 @@ func (hd *Handlers) handleCredential(w http.ResponseWriter, r *http.Request) {
 }
 
 func (hd *Handlers) handleCredentialInGroup(contract, templateID, credentialID string) (interface{}, error) {
-	switch credential, isActive, err := Credential(hd.database, contract, templateID, credentialID); {
+	switch credential, err := Credential(hd.database, contract, templateID, credentialID); {
 	case err != nil:
 		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential by contract %s, template %s, id %s", contract, templateID, credentialID)
 	case credential == nil:
 		return nil, mitumutil.ErrNotFound.Errorf("credential by contract %s, template %s, id %s", contract, templateID, credentialID)
 	default:
-		hal, err := hd.buildCredentialHal(contract, *credential, isActive)
+		hal, err := hd.buildCredentialHal(contract, *credential)
 		if err != nil {
 			return nil, err
 		}
@@ -117,9 +204,10 @@ func (hd *Handlers) handleCredentialInGroup(contract, templateID, credentialID s
 
 func (hd *Handlers) buildCredentialHal(
 	contract string,
-	credential types.Credential,
-	isActive bool,
+	cv state.CredentialStateValue,
 ) (currencydigest.Hal, error) {
+	credential := cv.Credential
+
 	h, err := hd.combineURL(
 		HandlerPathDIDCredential,
 		"contract", contract,
@@ -130,25 +218,212 @@ func (hd *Handlers) buildCredentialHal(
 		return nil, err
 	}
 
-	hal := currencydigest.NewBaseHal(
+	// NOTE expiration is not recorded in state; it is reported as of now.
+	status := cv.Status.At(credential.ValidUntil(), time.Now())
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(
 		struct {
-			Credential types.Credential `json:"credential"`
-			IsActive   bool             `json:"is_active"`
-		}{Credential: credential, IsActive: isActive},
+			Credential  types.Credential       `json:"credential"`
+			Status      types.CredentialStatus `json:"status"`
+			IsActive    bool                   `json:"is_active"`
+			Version     uint64                 `json:"version"`
+			Revocation  *types.Revocation      `json:"revocation,omitempty"`
+			StatusIndex *uint64                `json:"status_index,omitempty"`
+		}{
+			Credential:  credential,
+			Status:      status,
+			IsActive:    status == types.CredentialStatusActive,
+			Version:     cv.Version,
+			Revocation:  cv.Revocation,
+			StatusIndex: cv.StatusIndex,
+		},
 		currencydigest.NewHalLink(h, nil),
 	)
 
+	hh, err := hd.combineURL(
+		HandlerPathDIDCredentialHistory,
+		"contract", contract,
+		"templateid", credential.TemplateID(),
+		"credentialid", credential.ID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("history", currencydigest.NewHalLink(hh, nil))
+
+	hv, err := hd.combineURL(
+		HandlerPathDIDCredentialVC,
+		"contract", contract,
+		"templateid", credential.TemplateID(),
+		"credentialid", credential.ID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("vc", currencydigest.NewHalLink(hv, nil))
+
+	if cv.StatusIndex != nil {
+		hs, err := hd.combineURL(
+			HandlerPathDIDStatusList,
+			"contract", contract,
+			"templateid", credential.TemplateID(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("status-list", currencydigest.NewHalLink(hs, nil))
+	}
+
 	return hal, nil
 }
 
+func (hd *Handlers) handleCredentialHistory(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cacheKey := currencydigest.CacheKey(
+		r.URL.Path, currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := parseRequest(w, r, "credentialid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	var height *base.Height
+	if len(offset) > 0 {
+		h, err := base.ParseHeightString(offset)
+		if err != nil {
+			currencydigest.HTTP2ProblemWithError(w, errors.Wrap(err, "invalid offset"), http.StatusBadRequest)
+			return
+		}
+		height = &h
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleCredentialHistoryInGroup(contract, templateID, credentialID, height, reverse, limit)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleCredentialHistoryInGroup(
+	contract, templateID, credentialID string,
+	offset *base.Height,
+	reverse bool,
+	l int64,
+) (interface{}, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("credential-history")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextOffset base.Height
+	if err := CredentialHistory(
+		hd.database, contract, templateID, credentialID, reverse, offset, limit,
+		func(cv state.CredentialStateValue, st base.State) (bool, error) {
+			hal, err := hd.buildCredentialHal(contract, cv)
+			if err != nil {
+				return false, err
+			}
+			vas = append(vas, hal.AddExtras("height", st.Height()))
+			nextOffset = st.Height()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential history by contract %s, template %s, id %s", contract, templateID, credentialID)
+	} else if len(vas) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("credential history by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	baseSelf, err := hd.combineURL(
+		HandlerPathDIDCredentialHistory,
+		"contract", contract,
+		"templateid", templateID,
+		"credentialid", credentialID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if offset != nil {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringOffsetQuery(offset.String()))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	h, err := hd.combineURL(
+		HandlerPathDIDCredential,
+		"contract", contract,
+		"templateid", templateID,
+		"credentialid", credentialID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("credential", currencydigest.NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		next := currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(nextOffset.String()))
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	hal = hal.AddLink("reverse", currencydigest.NewHalLink(currencydigest.AddQueryValue(baseSelf, currencydigest.StringBoolQuery("reverse", !reverse)), nil))
+
+	return hd.encoder.Marshal(hal)
+}
+
 func (hd *Handlers) handleCredentials(w http.ResponseWriter, r *http.Request) {
 	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
 	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
 	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+	credentialFilter := CredentialFilter{
+		Status:     currencydigest.ParseStringQuery(r.URL.Query().Get("status")),
+		ReasonCode: currencydigest.ParseStringQuery(r.URL.Query().Get("reason_code")),
+		Revoker:    currencydigest.ParseStringQuery(r.URL.Query().Get("revoker")),
+	}
 
 	cachekey := currencydigest.CacheKey(
 		r.URL.Path, currencydigest.StringOffsetQuery(offset),
 		currencydigest.StringBoolQuery("reverse", reverse),
+		credentialFilter.query(),
 	)
 
 	contract, err, status := parseRequest(w, r, "contract")
@@ -165,7 +440,7 @@ func (hd *Handlers) handleCredentials(w http.ResponseWriter, r *http.Request) {
 	}
 
 	v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
-		i, filled, err := hd.handleCredentialsInGroup(contract, templateID, offset, reverse, limit)
+		i, filled, err := hd.handleCredentialsInGroup(contract, templateID, credentialFilter, offset, reverse, limit)
 
 		return []interface{}{i, filled}, err
 	})
@@ -199,6 +474,7 @@ func (hd *Handlers) handleCredentials(w http.ResponseWriter, r *http.Request) {
 
 func (hd *Handlers) handleCredentialsInGroup(
 	contract, templateID string,
+	credentialFilter CredentialFilter,
 	offset string,
 	reverse bool,
 	l int64,
@@ -212,9 +488,9 @@ func (hd *Handlers) handleCredentialsInGroup(
 
 	var vas []currencydigest.Hal
 	if err := CredentialsByServiceTemplate(
-		hd.database, contract, templateID, reverse, offset, limit,
-		func(credential types.Credential, isActive bool, st base.State) (bool, error) {
-			hal, err := hd.buildCredentialHal(contract, credential, isActive)
+		hd.database, contract, templateID, credentialFilter, reverse, offset, limit,
+		func(cv state.CredentialStateValue, _ base.State) (bool, error) {
+			hal, err := hd.buildCredentialHal(contract, cv)
 			if err != nil {
 				return false, err
 			}
@@ -228,7 +504,7 @@ func (hd *Handlers) handleCredentialsInGroup(
 		return nil, false, mitumutil.ErrNotFound.Errorf("credentials by contract %s, template %s", contract, templateID)
 	}
 
-	i, err := hd.buildCredentialsHal(contract, templateID, vas, offset, reverse)
+	i, err := hd.buildCredentialsHal(contract, templateID, credentialFilter, vas, offset, reverse)
 	if err != nil {
 		return nil, false, err
 	}
@@ -239,6 +515,7 @@ func (hd *Handlers) handleCredentialsInGroup(
 
 func (hd *Handlers) buildCredentialsHal(
 	contract, templateID string,
+	credentialFilter CredentialFilter,
 	vas []currencydigest.Hal,
 	offset string,
 	reverse bool,
@@ -251,6 +528,7 @@ func (hd *Handlers) buildCredentialsHal(
 	if err != nil {
 		return nil, err
 	}
+	baseSelf = currencydigest.AddQueryValue(baseSelf, credentialFilter.query())
 
 	self := baseSelf
 	if len(offset) > 0 {
@@ -273,8 +551,12 @@ func (hd *Handlers) buildCredentialsHal(
 
 	if len(vas) > 0 {
 		va, ok := vas[len(vas)-1].Interface().(struct {
-			Credential types.Credential `json:"credential"`
-			IsActive   bool             `json:"is_active"`
+			Credential  types.Credential       `json:"credential"`
+			Status      types.CredentialStatus `json:"status"`
+			IsActive    bool                   `json:"is_active"`
+			Version     uint64                 `json:"version"`
+			Revocation  *types.Revocation      `json:"revocation,omitempty"`
+			StatusIndex *uint64                `json:"status_index,omitempty"`
 		})
 		if !ok {
 			return nil, errors.Errorf("failed to build credentials hal")
@@ -298,6 +580,21 @@ func (hd *Handlers) buildCredentialsHal(
 	return hal, nil
 }
 
+func (f CredentialFilter) query() string {
+	q := url.Values{}
+	if len(f.Status) > 0 {
+		q.Set("status", f.Status)
+	}
+	if len(f.ReasonCode) > 0 {
+		q.Set("reason_code", f.ReasonCode)
+	}
+	if len(f.Revoker) > 0 {
+		q.Set("revoker", f.Revoker)
+	}
+
+	return q.Encode()
+}
+
 func (hd *Handlers) handleHolderCredential(w http.ResponseWriter, r *http.Request) {
 	cacheKey := currencydigest.CacheKeyPath(r)
 	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
@@ -342,8 +639,8 @@ func (hd *Handlers) handleHolderCredentialsInGroup(contract, holder string) (int
 	var vas []currencydigest.Hal
 	if err := CredentialsByServiceHolder(
 		hd.database, contract, holder,
-		func(credential types.Credential, isActive bool, st base.State) (bool, error) {
-			hal, err := hd.buildCredentialHal(contract, credential, isActive)
+		func(cv state.CredentialStateValue, _ base.State) (bool, error) {
+			hal, err := hd.buildCredentialHal(contract, cv)
 			if err != nil {
 				return false, err
 			}
@@ -415,13 +712,13 @@ func (hd *Handlers) handleTemplate(w http.ResponseWriter, r *http.Request) {
 }
 
 func (hd *Handlers) handleTemplateInGroup(contract, templateID string) (interface{}, error) {
-	switch template, err := Template(hd.database, contract, templateID); {
+	switch template, status, err := Template(hd.database, contract, templateID); {
 	case err != nil:
 		return nil, mitumutil.ErrNotFound.WithMessage(err, "template by contract %s, template %s", contract, templateID)
 	case template == nil:
 		return nil, mitumutil.ErrNotFound.Errorf("template by contract %s, template %s", contract, templateID)
 	default:
-		hal, err := hd.buildTemplateHal(contract, templateID, *template)
+		hal, err := hd.buildTemplateHal(contract, templateID, *template, status)
 		if err != nil {
 			return nil, err
 		}
@@ -432,6 +729,7 @@ func (hd *Handlers) handleTemplateInGroup(contract, templateID string) (interfac
 func (hd *Handlers) buildTemplateHal(
 	contract, templateID string,
 	template types.Template,
+	status types.TemplateStatus,
 ) (currencydigest.Hal, error) {
 	h, err := hd.combineURL(
 		HandlerPathDIDTemplate,
@@ -442,7 +740,21 @@ func (hd *Handlers) buildTemplateHal(
 		return nil, err
 	}
 
-	hal := currencydigest.NewBaseHal(template, currencydigest.NewHalLink(h, nil))
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(template, currencydigest.NewHalLink(h, nil))
+	hal = hal.AddExtras("status", status)
+
+	if template.MultiAudit() {
+		h, err := hd.combineURL(
+			HandlerPathDIDPendingAssignments,
+			"contract", contract,
+			"templateid", templateID,
+		)
+		if err != nil {
+			return nil, err
+		}
+		hal = hal.AddLink("pending-assignments", currencydigest.NewHalLink(h, nil))
+	}
 
 	return hal, nil
 }
@@ -459,3 +771,196 @@ func parseRequest(_ http.ResponseWriter, r *http.Request, v string) (string, err
 	}
 	return s, nil, http.StatusOK
 }
+
+// minStatusListBits is the minimum length of the published bitstring; the
+// StatusList2021 specification asks for 16KB to keep holders indistinguishable.
+var minStatusListBits = 131072
+
+type StatusList2021Subject struct {
+	ID            string `json:"id"`
+	Type          string `json:"type"`
+	StatusPurpose string `json:"statusPurpose"`
+	EncodedList   string `json:"encodedList"`
+}
+
+// StatusList2021Credential is the status list of the template in the
+// credential service. It has no proof; the list is read from the state of
+// the node, so the relying party trusts the digest node it queries. The list
+// of granted template is kept by the owner service, and is served under each
+// service with the service as issuer, like the credentials of the service.
+type StatusList2021Credential struct {
+	Context           []string              `json:"@context"`
+	ID                string                `json:"id"`
+	Type              []string              `json:"type"`
+	Issuer            string                `json:"issuer"`
+	IssuanceDate      string                `json:"issuanceDate"`
+	CredentialSubject StatusList2021Subject `json:"credentialSubject"`
+}
+
+func (hd *Handlers) handleStatusList(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleStatusListInGroup(contract, templateID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		_, _ = w.Write(v.([]byte))
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleStatusListInGroup(contract, templateID string) (interface{}, error) {
+	owner := TemplateOwner(hd.database, contract, templateID)
+
+	statusList, height, err := StatusList(hd.database, owner, templateID)
+	switch {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "status list by contract %s, template %s", owner, templateID)
+	case statusList == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("status list by contract %s, template %s", owner, templateID)
+	}
+
+	manifest, _, _, _, _, err := hd.database.ManifestByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	encodedList, err := encodeStatusList(*statusList)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDStatusList, "contract", contract, "templateid", templateID)
+	if err != nil {
+		return nil, err
+	}
+	h = hd.publicURL + h
+
+	return hd.encoder.Marshal(StatusList2021Credential{
+		Context:      []string{types.VCContextV1, types.StatusList2021ContextV1},
+		ID:           h,
+		Type:         []string{"VerifiableCredential", "StatusList2021Credential"},
+		Issuer:       types.ContractDID(string(hd.networkID), contract),
+		IssuanceDate: manifest.ProposedAt().UTC().Format(time.RFC3339),
+		CredentialSubject: StatusList2021Subject{
+			ID:            h + "#list",
+			Type:          "StatusList2021",
+			StatusPurpose: "revocation",
+			EncodedList:   encodedList,
+		},
+	})
+}
+
+func (hd *Handlers) handleCredentialVC(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := parseRequest(w, r, "credentialid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleCredentialVCInGroup(contract, templateID, credentialID)
+	}); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		w.Header().Set("Content-Type", "application/ld+json; charset=utf-8")
+		_, _ = w.Write(v.([]byte))
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	}
+}
+
+func (hd *Handlers) handleCredentialVCInGroup(contract, templateID, credentialID string) (interface{}, error) {
+	var template types.Template
+	switch t, _, err := ServiceTemplate(hd.database, contract, templateID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "template by contract %s, template %s", contract, templateID)
+	case t == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("template by contract %s, template %s", contract, templateID)
+	default:
+		template = *t
+	}
+
+	var cv state.CredentialStateValue
+	switch c, err := Credential(hd.database, contract, templateID, credentialID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	case c == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	default:
+		cv = *c
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDStatusList, "contract", contract, "templateid", templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(
+		types.NewVerifiableCredential(
+			string(hd.networkID), contract, template, cv.Credential, cv.StatusIndex, hd.publicURL+h, cv.IssuerSign,
+		),
+	)
+}
+
+// encodeStatusList pads the bitstring to minStatusListBits and returns it
+// gzip compressed and base64url encoded. Compression is applied here rather
+// than in state, because the compressed bytes are not stable across
+// compressor implementations.
+func encodeStatusList(statusList types.StatusList) (string, error) {
+	n := len(statusList.Bitstring())
+	if m := minStatusListBits / 8; n < m {
+		n = m
+	}
+
+	b := make([]byte, n)
+	copy(b, statusList.Bitstring())
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	if _, err := gw.Write(b); err != nil {
+		return "", err
+	}
+	if err := gw.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,122 @@
+package digest
+
+import (
+	"net/http"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+)
+
+func (hd *Handlers) handlePendingAssignments(w http.ResponseWriter, r *http.Request) {
+	limit := currencydigest.ParseLimitQuery(r.URL.Query().Get("limit"))
+	offset := currencydigest.ParseStringQuery(r.URL.Query().Get("offset"))
+	reverse := currencydigest.ParseBoolQuery(r.URL.Query().Get("reverse"))
+
+	cachekey := currencydigest.CacheKey(
+		r.URL.Path, currencydigest.StringOffsetQuery(offset),
+		currencydigest.StringBoolQuery("reverse", reverse),
+	)
+
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	if v, err, shared := hd.rg.Do(cachekey, func() (interface{}, error) {
+		return hd.handlePendingAssignmentsInGroup(contract, templateID, offset, reverse, limit)
+	}); err != nil {
+		hd.Log().Err(err).Str("Issuer", contract).Msg("failed to get pending assignments")
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cachekey, hd.expireNotFilled)
+		}
+	}
+}
+
+func (hd *Handlers) handlePendingAssignmentsInGroup(
+	contract, templateID string,
+	offset string,
+	reverse bool,
+	l int64,
+) (interface{}, error) {
+	var limit int64
+	if l < 0 {
+		limit = hd.itemsLimiter("service-pending-assignments")
+	} else {
+		limit = l
+	}
+
+	var vas []currencydigest.Hal
+	var nextOffset string
+	if err := PendingAssignmentsByServiceTemplate(
+		hd.database, contract, templateID, reverse, offset, limit,
+		func(pa state.PendingAssignmentStateValue, _ base.State) (bool, error) {
+			h, err := hd.combineURL(
+				HandlerPathDIDCredential,
+				"contract", contract,
+				"templateid", templateID,
+				"credentialid", pa.Credential.ID(),
+			)
+			if err != nil {
+				return false, err
+			}
+
+			vas = append(vas, currencydigest.NewBaseHal(pa, currencydigest.NewHalLink(h, nil)))
+			nextOffset = pa.Credential.ID()
+
+			return true, nil
+		},
+	); err != nil {
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "pending assignments by contract %s, template %s", contract, templateID)
+	} else if len(vas) < 1 {
+		return nil, mitumutil.ErrNotFound.Errorf("pending assignments by contract %s, template %s", contract, templateID)
+	}
+
+	baseSelf, err := hd.combineURL(
+		HandlerPathDIDPendingAssignments,
+		"contract", contract,
+		"templateid", templateID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	self := baseSelf
+	if len(offset) > 0 {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringOffsetQuery(offset))
+	}
+	if reverse {
+		self = currencydigest.AddQueryValue(self, currencydigest.StringBoolQuery("reverse", reverse))
+	}
+
+	var hal currencydigest.Hal
+	hal = currencydigest.NewBaseHal(vas, currencydigest.NewHalLink(self, nil))
+
+	h, err := hd.combineURL(HandlerPathDIDTemplate, "contract", contract, "templateid", templateID)
+	if err != nil {
+		return nil, err
+	}
+	hal = hal.AddLink("template", currencydigest.NewHalLink(h, nil))
+
+	if int64(len(vas)) == limit {
+		next := currencydigest.AddQueryValue(baseSelf, currencydigest.StringOffsetQuery(nextOffset))
+		if reverse {
+			next = currencydigest.AddQueryValue(next, currencydigest.StringBoolQuery("reverse", reverse))
+		}
+
+		hal = hal.AddLink("next", currencydigest.NewHalLink(next, nil))
+	}
+
+	return hd.encoder.Marshal(hal)
+}
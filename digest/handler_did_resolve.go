@@ -0,0 +1,141 @@
+package digest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+var (
+	DIDResolutionContextV1 = "https://w3id.org/did-resolution/v1"
+	DIDResolutionMediaType = `application/ld+json;profile="https://w3id.org/did-resolution"`
+	DIDDocumentMediaType   = "application/did+ld+json"
+)
+
+const (
+	DIDResolutionErrorInvalidDID         = "invalidDid"
+	DIDResolutionErrorNotFound           = "notFound"
+	DIDResolutionErrorMethodNotSupported = "methodNotSupported"
+)
+
+type DIDResolutionMetadata struct {
+	ContentType string `json:"contentType,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+type DIDDocumentMetadata struct {
+	VersionID string `json:"versionId,omitempty"`
+}
+
+type DIDResolutionResult struct {
+	Context               string                `json:"@context"`
+	DIDDocument           *types.DIDDocument    `json:"didDocument"`
+	DIDResolutionMetadata DIDResolutionMetadata `json:"didResolutionMetadata"`
+	DIDDocumentMetadata   DIDDocumentMetadata   `json:"didDocumentMetadata"`
+}
+
+func newDIDResolutionErrorResult(e string) DIDResolutionResult {
+	return DIDResolutionResult{
+		Context:               DIDResolutionContextV1,
+		DIDResolutionMetadata: DIDResolutionMetadata{Error: e},
+	}
+}
+
+func (hd *Handlers) handleDIDResolve(w http.ResponseWriter, r *http.Request) {
+	cacheKey := currencydigest.CacheKeyPath(r)
+	if err := currencydigest.LoadFromCache(hd.cache, cacheKey, w); err == nil {
+		return
+	}
+
+	did, err, status := parseRequest(w, r, "did")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	v, err, shared := hd.rg.Do(cacheKey, func() (interface{}, error) {
+		return hd.handleDIDResolveInGroup(did)
+	})
+	if err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+		return
+	}
+
+	result := v.(DIDResolutionResult)
+
+	b, err := hd.encoder.Marshal(result)
+	if err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", DIDResolutionMediaType)
+
+	switch result.DIDResolutionMetadata.Error {
+	case "":
+		_, _ = w.Write(b)
+		if !shared {
+			currencydigest.HTTP2WriteCache(w, cacheKey, time.Second*3)
+		}
+	case DIDResolutionErrorInvalidDID:
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(b)
+	case DIDResolutionErrorNotFound:
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write(b)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+		_, _ = w.Write(b)
+	}
+}
+
+// handleDIDResolveInGroup resolves did:mitum:<network>:<address> to the DID
+// Document of the account. The network must be the network id of the node;
+// the DID without network is resolved in the network of the node.
+func (hd *Handlers) handleDIDResolveInGroup(s string) (interface{}, error) {
+	did := types.DID(s)
+	if err := did.IsValid(nil); err != nil {
+		return newDIDResolutionErrorResult(DIDResolutionErrorInvalidDID), nil
+	}
+
+	if did.Method() != types.DIDMethod {
+		return newDIDResolutionErrorResult(DIDResolutionErrorMethodNotSupported), nil
+	}
+
+	network, address, err := types.ParseAccountDID(did)
+	if err != nil {
+		return newDIDResolutionErrorResult(DIDResolutionErrorInvalidDID), nil
+	}
+
+	if len(network) > 0 && network != string(hd.networkID) {
+		return newDIDResolutionErrorResult(DIDResolutionErrorNotFound), nil
+	}
+
+	a, err := base.DecodeAddress(address, hd.encoder)
+	if err != nil {
+		return newDIDResolutionErrorResult(DIDResolutionErrorInvalidDID), nil
+	}
+
+	switch va, found, err := hd.database.Account(a); {
+	case err != nil:
+		return nil, err
+	case !found:
+		return newDIDResolutionErrorResult(DIDResolutionErrorNotFound), nil
+	default:
+		doc := types.NewDIDDocument(s, va.Account().Keys())
+
+		return DIDResolutionResult{
+			Context:     DIDResolutionContextV1,
+			DIDDocument: &doc,
+			DIDResolutionMetadata: DIDResolutionMetadata{
+				ContentType: DIDDocumentMediaType,
+			},
+			DIDDocumentMetadata: DIDDocumentMetadata{
+				VersionID: va.Height().String(),
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,688 @@
+package digest
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencydigest "github.com/ProtoconNet/mitum-currency/v3/digest"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	mitumutil "github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+	"github.com/pkg/errors"
+)
+
+// maxVerifyRequestBody limits the body of verification requests.
+var maxVerifyRequestBody int64 = 1 << 16
+
+type CredentialValueRequest struct {
+	Value string `json:"value"`
+	Salt  string `json:"salt"`
+}
+
+type CredentialValueVerification struct {
+	Verified bool                   `json:"verified"`
+	Status   types.CredentialStatus `json:"status"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// handleCredentialVerifyValue checks the value and salt presented by holder
+// against the commitment of credential. The value is sent in body, not in
+// url, and the result is not cached.
+func (hd *Handlers) handleCredentialVerifyValue(w http.ResponseWriter, r *http.Request) {
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := parseRequest(w, r, "credentialid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	var req CredentialValueRequest
+	if err := hd.decodeRequestBody(w, r, &req); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if v, err := hd.handleCredentialVerifyValueInGroup(contract, templateID, credentialID, req); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+	}
+}
+
+func (hd *Handlers) handleCredentialVerifyValueInGroup(
+	contract, templateID, credentialID string, req CredentialValueRequest,
+) (interface{}, error) {
+	var template types.Template
+	switch t, _, err := ServiceTemplate(hd.database, contract, templateID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "template by contract %s, template %s", contract, templateID)
+	case t == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("template by contract %s, template %s", contract, templateID)
+	default:
+		template = *t
+	}
+
+	c, err := Credential(hd.database, contract, templateID, credentialID)
+	switch {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	case c == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	result := CredentialValueVerification{Verified: true, Status: c.Status}
+	if err := types.VerifyCredentialValue(template, c.Credential, req.Value, req.Salt); err != nil {
+		result = CredentialValueVerification{Status: c.Status, Error: err.Error()}
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDCredential,
+		"contract", contract, "templateid", templateID, "credentialid", credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(currencydigest.NewBaseHal(result, currencydigest.NewHalLink(h, nil)))
+}
+
+func (hd *Handlers) decodeRequestBody(w http.ResponseWriter, r *http.Request, v interface{}) error {
+	b, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxVerifyRequestBody))
+	if err != nil {
+		return errors.Wrap(err, "failed to read request body")
+	}
+
+	if err := hd.encoder.Unmarshal(b, v); err != nil {
+		return errors.Wrap(err, "invalid request body")
+	}
+
+	return nil
+}
+
+type DisclosurePresentation struct {
+	Holder      string             `json:"holder"`
+	Disclosures []string           `json:"disclosures"`
+	Challenge   string             `json:"challenge"`
+	Signs       []PresentationSign `json:"signs"`
+}
+
+// DisclosureVerification is verified when the disclosures are signed by the
+// holder of credential and match the claim digests of credential.
+type DisclosureVerification struct {
+	Verified        bool                   `json:"verified"`
+	Status          types.CredentialStatus `json:"status"`
+	HolderSignature PresentationCheck      `json:"holder_signature"`
+	Holder          PresentationCheck      `json:"holder"`
+	Claims          map[string]interface{} `json:"claims,omitempty"`
+	Error           string                 `json:"error,omitempty"`
+}
+
+// handleCredentialVerifyDisclosures checks the disclosures presented by
+// holder against the claim digests of selective disclosure credential. The
+// holder signs the disclosures with the challenge of relying party, as in
+// the presentation.
+func (hd *Handlers) handleCredentialVerifyDisclosures(w http.ResponseWriter, r *http.Request) {
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	credentialID, err, status := parseRequest(w, r, "credentialid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	var req DisclosurePresentation
+	if err := hd.decodeRequestBody(w, r, &req); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	holder, err := checkDisclosurePresentation(req, hd.encoder)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if v, err := hd.handleCredentialVerifyDisclosuresInGroup(contract, templateID, credentialID, holder, req); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+	}
+}
+
+func checkDisclosurePresentation(req DisclosurePresentation, enc encoder.Encoder) (base.Address, error) {
+	holder, err := base.DecodeAddress(req.Holder, enc)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid holder")
+	}
+
+	if len(req.Disclosures) < 1 {
+		return nil, errors.Errorf("empty disclosures")
+	}
+
+	if l := len(req.Challenge); l < 1 || l > maxLengthPresentationChallenge {
+		return nil, errors.Errorf("invalid length of challenge, 0 < length <= %d", maxLengthPresentationChallenge)
+	}
+
+	if len(req.Signs) < 1 {
+		return nil, errors.Errorf("empty signs")
+	}
+
+	return holder, nil
+}
+
+func (hd *Handlers) handleCredentialVerifyDisclosuresInGroup(
+	contract, templateID, credentialID string, holder base.Address, req DisclosurePresentation,
+) (interface{}, error) {
+	var template types.Template
+	switch t, _, err := ServiceTemplate(hd.database, contract, templateID); {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "template by contract %s, template %s", contract, templateID)
+	case t == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("template by contract %s, template %s", contract, templateID)
+	default:
+		template = *t
+	}
+
+	c, err := Credential(hd.database, contract, templateID, credentialID)
+	switch {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	case c == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("credential by contract %s, template %s, id %s", contract, templateID, credentialID)
+	}
+
+	ref := types.CredentialRef{Contract: contract, TemplateID: templateID, ID: credentialID}
+
+	sig, err := hd.checkPresentationSigns(
+		holder, types.DisclosureBytes(req.Holder, ref, req.Disclosures, req.Challenge), req.Signs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := DisclosureVerification{
+		Status:          c.Status,
+		HolderSignature: sig,
+		Holder:          PresentationCheck{Passed: c.Credential.Holder().Equal(holder)},
+	}
+
+	if !result.Holder.Passed {
+		result.Holder.Reason = "credential not held by holder"
+	}
+
+	if claims, err := types.VerifyDisclosures(template, c.Credential, req.Disclosures); err != nil {
+		result.Error = err.Error()
+	} else {
+		result.Verified = result.HolderSignature.Passed && result.Holder.Passed
+		result.Claims = claims
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDCredential,
+		"contract", contract, "templateid", templateID, "credentialid", credentialID)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(currencydigest.NewBaseHal(result, currencydigest.NewHalLink(h, nil)))
+}
+
+type BatchCredentialPresentation struct {
+	Credential BatchCredential `json:"credential"`
+	Index      uint64          `json:"index"`
+	Proof      []string        `json:"proof"`
+}
+
+type BatchCredential struct {
+	Holder     string `json:"holder"`
+	TemplateID string `json:"template_id"`
+	ID         string `json:"id"`
+	Value      string `json:"value"`
+	ValidFrom  uint64 `json:"valid_from"`
+	ValidUntil uint64 `json:"valid_until"`
+	DID        string `json:"did"`
+}
+
+type BatchCredentialVerification struct {
+	Verified   bool                   `json:"verified"`
+	Status     types.CredentialStatus `json:"status,omitempty"`
+	Revocation *types.Revocation      `json:"revocation,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+// handleBatchVerify checks the credential presented by holder is included in
+// the anchored batch by the merkle inclusion proof. The credentials of the
+// revoked batch are verified with the revoked status; the status and the
+// revocation are given only for the verified credential.
+func (hd *Handlers) handleBatchVerify(w http.ResponseWriter, r *http.Request) {
+	contract, err, status := parseRequest(w, r, "contract")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	templateID, err, status := parseRequest(w, r, "templateid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	batchID, err, status := parseRequest(w, r, "batchid")
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, status)
+		return
+	}
+
+	var req BatchCredentialPresentation
+	if err := hd.decodeRequestBody(w, r, &req); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if v, err := hd.handleBatchVerifyInGroup(contract, templateID, batchID, req); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+	}
+}
+
+func (hd *Handlers) handleBatchVerifyInGroup(
+	contract, templateID, batchID string, req BatchCredentialPresentation,
+) (interface{}, error) {
+	batch, _, err := BatchAnchor(hd.database, contract, templateID, batchID)
+	switch {
+	case err != nil:
+		return nil, mitumutil.ErrNotFound.WithMessage(err, "batch by contract %s, template %s, id %s", contract, templateID, batchID)
+	case batch == nil:
+		return nil, mitumutil.ErrNotFound.Errorf("batch by contract %s, template %s, id %s", contract, templateID, batchID)
+	}
+
+	var result BatchCredentialVerification
+
+	if err := verifyBatchCredential(*batch, templateID, req, hd.encoder); err != nil {
+		result.Error = err.Error()
+	} else {
+		status := types.CredentialStatusActive
+		if batch.IsRevoked() {
+			status = types.CredentialStatusRevoked
+		}
+
+		result.Verified = true
+		result.Status = status.At(req.Credential.ValidUntil, time.Now())
+		result.Revocation = batch.Revocation
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDTemplate, "contract", contract, "templateid", templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(currencydigest.NewBaseHal(result, currencydigest.NewHalLink(h, nil)))
+}
+
+func verifyBatchCredential(
+	batch state.BatchAnchorStateValue, templateID string, req BatchCredentialPresentation, enc encoder.Encoder,
+) error {
+	c := req.Credential
+
+	if c.TemplateID != templateID {
+		return errors.Errorf("template of credential not matched, %q != %q", c.TemplateID, templateID)
+	}
+
+	holder, err := base.DecodeAddress(c.Holder, enc)
+	if err != nil {
+		return errors.WithMessage(err, "invalid holder")
+	}
+
+	credential := types.NewCredential(holder, c.TemplateID, c.ID, c.Value, c.ValidFrom, c.ValidUntil, c.DID)
+	if err := credential.IsValid(nil); err != nil {
+		return err
+	}
+
+	root, err := hex.DecodeString(batch.Root)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	proof, err := types.DecodeMerkleProof(req.Proof)
+	if err != nil {
+		return err
+	}
+
+	if !types.VerifyMerkleProof(root, types.CredentialLeaf(credential), req.Index, batch.Count, proof) {
+		return errors.Errorf("credential not included in batch")
+	}
+
+	return nil
+}
+
+var (
+	// maxPresentationCredentials limits the credentials of a presentation.
+	maxPresentationCredentials     = 16
+	maxLengthPresentationChallenge = 256
+)
+
+type PresentationRequest struct {
+	Holder      string                `json:"holder"`
+	Credentials []types.CredentialRef `json:"credentials"`
+	Challenge   string                `json:"challenge"`
+	Signs       []PresentationSign    `json:"signs"`
+}
+
+type PresentationSign struct {
+	Signer    string         `json:"signer"`
+	Signature base.Signature `json:"signature"`
+	SignedAt  localtime.Time `json:"signed_at"`
+}
+
+type PresentationCheck struct {
+	Passed bool   `json:"passed"`
+	Reason string `json:"reason,omitempty"`
+}
+
+type PresentationVerification struct {
+	Verified        bool                              `json:"verified"`
+	Holder          string                            `json:"holder"`
+	Challenge       string                            `json:"challenge"`
+	HolderSignature PresentationCheck                 `json:"holder_signature"`
+	Credentials     []PresentedCredentialVerification `json:"credentials"`
+}
+
+// PresentedCredentialVerification is the result of the checks of the
+// presented credential; the checks after exists are omitted when the
+// credential is not found.
+type PresentedCredentialVerification struct {
+	types.CredentialRef
+	Verified    bool                   `json:"verified"`
+	Status      types.CredentialStatus `json:"status,omitempty"`
+	Exists      PresentationCheck      `json:"exists"`
+	Holder      *PresentationCheck     `json:"holder,omitempty"`
+	Active      *PresentationCheck     `json:"active,omitempty"`
+	Validity    *PresentationCheck     `json:"validity,omitempty"`
+	Template    *PresentationCheck     `json:"template,omitempty"`
+	IssuerProof *PresentationCheck     `json:"issuer_proof,omitempty"`
+}
+
+// handleVerifyPresentation checks the presentation of holder; the signature
+// of holder over the credentials and challenge, and the existence, holder,
+// status, validity, template and issuer sign of each credential. The failed
+// checks are reported with reasons, not as errors.
+//
+// Only the credentials in state are supported; the credential anchored in
+// batch is not found by its reference and is verified with its merkle proof
+// by the batch verify api.
+func (hd *Handlers) handleVerifyPresentation(w http.ResponseWriter, r *http.Request) {
+	var req PresentationRequest
+	if err := hd.decodeRequestBody(w, r, &req); err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	holder, err := checkPresentationRequest(req, hd.encoder)
+	if err != nil {
+		currencydigest.HTTP2ProblemWithError(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if v, err := hd.handleVerifyPresentationInGroup(holder, req); err != nil {
+		currencydigest.HTTP2HandleError(w, err)
+	} else {
+		currencydigest.HTTP2WriteHalBytes(hd.encoder, w, v.([]byte), http.StatusOK)
+	}
+}
+
+func checkPresentationRequest(req PresentationRequest, enc encoder.Encoder) (base.Address, error) {
+	holder, err := base.DecodeAddress(req.Holder, enc)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid holder")
+	}
+
+	switch l := len(req.Credentials); {
+	case l < 1:
+		return nil, errors.Errorf("empty credentials")
+	case l > maxPresentationCredentials:
+		return nil, errors.Errorf("too many credentials, %d > %d", l, maxPresentationCredentials)
+	}
+
+	refs := map[types.CredentialRef]struct{}{}
+	for i := range req.Credentials {
+		ref := req.Credentials[i]
+		if len(ref.Contract) < 1 || len(ref.TemplateID) < 1 || len(ref.ID) < 1 {
+			return nil, errors.Errorf("empty contract, template id or id of credential, %d", i)
+		}
+
+		if _, found := refs[ref]; found {
+			return nil, errors.Errorf("duplicated credential, %d", i)
+		}
+		refs[ref] = struct{}{}
+	}
+
+	if l := len(req.Challenge); l < 1 || l > maxLengthPresentationChallenge {
+		return nil, errors.Errorf("invalid length of challenge, 0 < length <= %d", maxLengthPresentationChallenge)
+	}
+
+	if len(req.Signs) < 1 {
+		return nil, errors.Errorf("empty signs")
+	}
+
+	return holder, nil
+}
+
+func (hd *Handlers) handleVerifyPresentationInGroup(holder base.Address, req PresentationRequest) (interface{}, error) {
+	sig, err := hd.checkPresentationSigns(
+		holder, types.PresentationBytes(req.Holder, req.Credentials, req.Challenge), req.Signs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := PresentationVerification{
+		Verified:        sig.Passed,
+		Holder:          req.Holder,
+		Challenge:       req.Challenge,
+		HolderSignature: sig,
+		Credentials:     make([]PresentedCredentialVerification, len(req.Credentials)),
+	}
+
+	now := time.Now()
+
+	for i := range req.Credentials {
+		result.Credentials[i] = hd.checkPresentedCredential(holder, req.Credentials[i], now)
+
+		if !result.Credentials[i].Verified {
+			result.Verified = false
+		}
+	}
+
+	h, err := hd.combineURL(HandlerPathDIDVerifyPresentation)
+	if err != nil {
+		return nil, err
+	}
+
+	return hd.encoder.Marshal(currencydigest.NewBaseHal(result, currencydigest.NewHalLink(h, nil)))
+}
+
+// checkPresentationSigns verifies the signs over the presented bytes and
+// checks the signs reach the threshold of the keys of holder account.
+func (hd *Handlers) checkPresentationSigns(
+	holder base.Address, b []byte, presented []PresentationSign,
+) (PresentationCheck, error) {
+	var keys currencytypes.AccountKeys
+	switch va, found, err := hd.database.Account(holder); {
+	case err != nil:
+		return PresentationCheck{}, err
+	case !found:
+		return PresentationCheck{Reason: "holder account not found"}, nil
+	default:
+		keys = va.Account().Keys()
+	}
+
+	if keys == nil {
+		return PresentationCheck{Reason: "empty keys of holder account"}, nil
+	}
+
+	signs := make([]base.BaseSign, len(presented))
+	for i := range presented {
+		s := presented[i]
+
+		signer, err := base.DecodePublickeyFromString(s.Signer, hd.encoder)
+		if err != nil {
+			return PresentationCheck{Reason: fmt.Sprintf("invalid signer, %d", i)}, nil
+		}
+
+		signs[i] = base.NewBaseSign(signer, s.Signature, s.SignedAt.Time)
+	}
+
+	return verifyHolderSigns(hd.networkID, keys, b, signs), nil
+}
+
+// verifyHolderSigns verifies the signs over b and checks the signs reach the
+// threshold of keys of holder.
+func verifyHolderSigns(
+	networkID base.NetworkID, keys currencytypes.AccountKeys, b []byte, presented []base.BaseSign,
+) PresentationCheck {
+	signers := map[string]struct{}{}
+	signs := make([]base.Sign, len(presented))
+	for i := range presented {
+		sign := presented[i]
+
+		if _, found := signers[sign.Signer().String()]; found {
+			return PresentationCheck{Reason: fmt.Sprintf("duplicated signer, %q", sign.Signer())}
+		}
+		signers[sign.Signer().String()] = struct{}{}
+
+		if err := sign.Verify(networkID, b); err != nil {
+			return PresentationCheck{Reason: fmt.Sprintf("invalid signature of signer, %q", sign.Signer())}
+		}
+
+		signs[i] = sign
+	}
+
+	if err := currencytypes.CheckThreshold(signs, keys); err != nil {
+		return PresentationCheck{Reason: err.Error()}
+	}
+
+	return PresentationCheck{Passed: true}
+}
+
+func (hd *Handlers) checkPresentedCredential(
+	holder base.Address, ref types.CredentialRef, now time.Time,
+) PresentedCredentialVerification {
+	result := PresentedCredentialVerification{CredentialRef: ref}
+
+	cv, err := Credential(hd.database, ref.Contract, ref.TemplateID, ref.ID)
+	if err != nil || cv == nil {
+		result.Exists = PresentationCheck{Reason: "credential not found"}
+
+		return result
+	}
+
+	result.Exists = PresentationCheck{Passed: true}
+
+	c := cv.Credential
+
+	result.Holder = &PresentationCheck{Passed: true}
+	if !c.Holder().Equal(holder) {
+		result.Holder = &PresentationCheck{Reason: fmt.Sprintf("holder not matched, %q", c.Holder())}
+	}
+
+	result.Status = cv.Status.At(c.ValidUntil(), now)
+
+	result.Active = &PresentationCheck{Passed: true}
+	if result.Status != types.CredentialStatusActive {
+		result.Active = &PresentationCheck{Reason: fmt.Sprintf("credential %s", result.Status)}
+	}
+
+	result.Validity = &PresentationCheck{Passed: true}
+	switch u := uint64(now.Unix()); {
+	case u < c.ValidFrom():
+		result.Validity = &PresentationCheck{Reason: fmt.Sprintf("not yet valid, valid from %d", c.ValidFrom())}
+	case u >= c.ValidUntil():
+		result.Validity = &PresentationCheck{Reason: fmt.Sprintf("expired, valid until %d", c.ValidUntil())}
+	}
+
+	// NOTE the credentials of deprecated template remain valid; only the
+	// removed template fails.
+	switch t, status, err := ServiceTemplate(hd.database, ref.Contract, ref.TemplateID); {
+	case err != nil || t == nil:
+		result.Template = &PresentationCheck{Reason: "template not found"}
+	case status == types.TemplateStatusRemoved:
+		result.Template = &PresentationCheck{Reason: fmt.Sprintf("template %s", status)}
+	default:
+		result.Template = &PresentationCheck{Passed: true}
+	}
+
+	result.IssuerProof = hd.checkIssuerProof(ref.Contract, cv)
+
+	result.Verified = result.Holder.Passed && result.Active.Passed &&
+		result.Validity.Passed && result.Template.Passed && result.IssuerProof.Passed
+
+	return result
+}
+
+// checkIssuerProof verifies the issuer sign of the credential and checks the
+// signer is the key of the owner or operators of the contract account.
+func (hd *Handlers) checkIssuerProof(contract string, cv *state.CredentialStateValue) *PresentationCheck {
+	if cv.IssuerSign == nil {
+		return &PresentationCheck{Reason: "issuer sign not found"}
+	}
+
+	a, err := base.DecodeAddress(contract, hd.encoder)
+	if err != nil {
+		return &PresentationCheck{Reason: "invalid contract"}
+	}
+
+	if err := cv.IssuerSign.Verify(hd.networkID, a, cv.Credential); err != nil {
+		return &PresentationCheck{Reason: "invalid issuer sign"}
+	}
+
+	ca, found, err := hd.database.Account(a)
+	if err != nil || !found {
+		return &PresentationCheck{Reason: "contract account not found"}
+	}
+
+	status := ca.ContractAccountStatus()
+	issuers := append([]base.Address{status.Owner()}, status.Operators()...)
+
+	for i := range issuers {
+		if issuers[i] == nil {
+			continue
+		}
+
+		va, found, err := hd.database.Account(issuers[i])
+		if err != nil || !found || va.Account().Keys() == nil {
+			continue
+		}
+
+		if _, found := va.Account().Keys().Key(cv.IssuerSign.Signer()); found {
+			return &PresentationCheck{Passed: true}
+		}
+	}
+
+	return &PresentationCheck{
+		Reason: fmt.Sprintf("issuer signer not key of owner or operators, %q", cv.IssuerSign.Signer()),
+	}
+}
@@ -0,0 +1,125 @@
+package digest
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func testHolderKeys(t *testing.T, threshold uint, privs ...base.Privatekey) currencytypes.AccountKeys {
+	t.Helper()
+
+	ks := make([]currencytypes.AccountKey, len(privs))
+	for i := range privs {
+		k, err := currencytypes.NewBaseAccountKey(privs[i].Publickey(), 50)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ks[i] = k
+	}
+
+	keys, err := currencytypes.NewBaseAccountKeys(ks, threshold)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return keys
+}
+
+func TestVerifyHolderSignsOfDisclosures(t *testing.T) {
+	networkID := base.NetworkID("test")
+	holder := "holder"
+	ref := types.CredentialRef{Contract: "contract", TemplateID: "template", ID: "id"}
+	disclosures := []string{"d0", "d1"}
+	challenge := "challenge"
+
+	b := types.DisclosureBytes(holder, ref, disclosures, challenge)
+
+	priv0, priv1, other := base.NewMPrivatekey(), base.NewMPrivatekey(), base.NewMPrivatekey()
+
+	sign := func(priv base.Privatekey, nid base.NetworkID, b []byte) base.BaseSign {
+		s, err := base.NewBaseSignFromBytes(priv, nid, b)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return s
+	}
+
+	cases := []struct {
+		name   string
+		keys   currencytypes.AccountKeys
+		signs  []base.BaseSign
+		passed bool
+	}{
+		{
+			name:   "signed by holder",
+			keys:   testHolderKeys(t, 50, priv0),
+			signs:  []base.BaseSign{sign(priv0, networkID, b)},
+			passed: true,
+		},
+		{
+			name:   "threshold of holder keys",
+			keys:   testHolderKeys(t, 100, priv0, priv1),
+			signs:  []base.BaseSign{sign(priv0, networkID, b), sign(priv1, networkID, b)},
+			passed: true,
+		},
+		{
+			name:  "wrong challenge",
+			keys:  testHolderKeys(t, 50, priv0),
+			signs: []base.BaseSign{sign(priv0, networkID, types.DisclosureBytes(holder, ref, disclosures, "challenge0"))},
+		},
+		{
+			name:  "tampered disclosure",
+			keys:  testHolderKeys(t, 50, priv0),
+			signs: []base.BaseSign{sign(priv0, networkID, types.DisclosureBytes(holder, ref, []string{"d0", "d2"}, challenge))},
+		},
+		{
+			name:  "other credential",
+			keys:  testHolderKeys(t, 50, priv0),
+			signs: []base.BaseSign{sign(priv0, networkID, types.DisclosureBytes(holder, types.CredentialRef{Contract: "contract", TemplateID: "template", ID: "id0"}, disclosures, challenge))},
+		},
+		{
+			name:  "presentation signs",
+			keys:  testHolderKeys(t, 50, priv0),
+			signs: []base.BaseSign{sign(priv0, networkID, types.PresentationBytes(holder, []types.CredentialRef{ref}, challenge))},
+		},
+		{
+			name:  "other network",
+			keys:  testHolderKeys(t, 50, priv0),
+			signs: []base.BaseSign{sign(priv0, base.NetworkID("test0"), b)},
+		},
+		{
+			name:  "not key of holder",
+			keys:  testHolderKeys(t, 50, priv0),
+			signs: []base.BaseSign{sign(other, networkID, b)},
+		},
+		{
+			name:  "under threshold",
+			keys:  testHolderKeys(t, 100, priv0, priv1),
+			signs: []base.BaseSign{sign(priv0, networkID, b)},
+		},
+		{
+			name:  "duplicated signer",
+			keys:  testHolderKeys(t, 100, priv0, priv1),
+			signs: []base.BaseSign{sign(priv0, networkID, b), sign(priv0, networkID, b)},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := verifyHolderSigns(networkID, tc.keys, b, tc.signs)
+
+			if r.Passed != tc.passed {
+				t.Fatalf("passed=%v, reason=%q", r.Passed, r.Reason)
+			}
+
+			if !r.Passed && len(r.Reason) < 1 {
+				t.Fatal("empty reason")
+			}
+		})
+	}
+}
@@ -19,19 +19,25 @@ var (
 
 type AddTemplateFact struct {
 	base.BaseFact
-	sender         base.Address
-	contract       base.Address
-	templateID     string
-	templateName   string
-	serviceDate    types.Date
-	expirationDate types.Date
-	templateShare  types.Bool
-	multiAudit     types.Bool
-	displayName    string
-	subjectKey     string
-	description    string
-	creator        base.Address
-	currency       currencytypes.CurrencyID
+	sender              base.Address
+	contract            base.Address
+	templateID          string
+	templateName        string
+	serviceDate         types.Date
+	expirationDate      types.Date
+	templateShare       types.Bool
+	multiAudit          types.Bool
+	consentRequired     types.Bool
+	privateValue        types.Bool
+	selectiveDisclosure types.Bool
+	auditorSet          *types.AuditorSet
+	quota               *types.Quota
+	schema              *types.CredentialSchema
+	displayName         string
+	subjectKey          string
+	description         string
+	creator             base.Address
+	currency            currencytypes.CurrencyID
 }
 
 func NewAddTemplateFact(
@@ -44,6 +50,12 @@ func NewAddTemplateFact(
 	expirationDate types.Date,
 	templateShare types.Bool,
 	multiAudit types.Bool,
+	consentRequired types.Bool,
+	privateValue types.Bool,
+	selectiveDisclosure types.Bool,
+	auditorSet *types.AuditorSet,
+	quota *types.Quota,
+	schema *types.CredentialSchema,
 	displayName string,
 	subjectKey string,
 	description string,
@@ -52,20 +64,26 @@ func NewAddTemplateFact(
 ) AddTemplateFact {
 	bf := base.NewBaseFact(AddTemplateFactHint, token)
 	fact := AddTemplateFact{
-		BaseFact:       bf,
-		sender:         sender,
-		contract:       contract,
-		templateID:     templateID,
-		templateName:   templateName,
-		serviceDate:    serviceDate,
-		expirationDate: expirationDate,
-		templateShare:  templateShare,
-		multiAudit:     multiAudit,
-		displayName:    displayName,
-		subjectKey:     subjectKey,
-		description:    description,
-		creator:        creator,
-		currency:       currency,
+		BaseFact:            bf,
+		sender:              sender,
+		contract:            contract,
+		templateID:          templateID,
+		templateName:        templateName,
+		serviceDate:         serviceDate,
+		expirationDate:      expirationDate,
+		templateShare:       templateShare,
+		multiAudit:          multiAudit,
+		consentRequired:     consentRequired,
+		privateValue:        privateValue,
+		selectiveDisclosure: selectiveDisclosure,
+		auditorSet:          auditorSet,
+		quota:               quota,
+		schema:              schema,
+		displayName:         displayName,
+		subjectKey:          subjectKey,
+		description:         description,
+		creator:             creator,
+		currency:            currency,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -81,6 +99,21 @@ func (fact AddTemplateFact) GenerateHash() util.Hash {
 }
 
 func (fact AddTemplateFact) Bytes() []byte {
+	var auditorSet []byte
+	if fact.auditorSet != nil {
+		auditorSet = fact.auditorSet.Bytes()
+	}
+
+	var quota []byte
+	if fact.quota != nil {
+		quota = fact.quota.Bytes()
+	}
+
+	var schema []byte
+	if fact.schema != nil {
+		schema = fact.schema.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		fact.Token(),
 		fact.sender.Bytes(),
@@ -91,6 +124,12 @@ func (fact AddTemplateFact) Bytes() []byte {
 		fact.expirationDate.Bytes(),
 		fact.templateShare.Bytes(),
 		fact.multiAudit.Bytes(),
+		fact.consentRequired.FlagBytes(),
+		fact.privateValue.FlagBytes(),
+		fact.selectiveDisclosure.FlagBytes(),
+		auditorSet,
+		quota,
+		schema,
 		[]byte(fact.displayName),
 		[]byte(fact.subjectKey),
 		[]byte(fact.description),
@@ -153,6 +192,26 @@ func (fact AddTemplateFact) IsValid(b []byte) error {
 		return util.ErrInvalid.Errorf("expire date <= service date, %s <= %s", fact.expirationDate, fact.serviceDate)
 	}
 
+	if fact.privateValue && fact.selectiveDisclosure {
+		return util.ErrInvalid.Errorf("private value with selective disclosure")
+	}
+
+	if err := isValidAuditorSet(fact.multiAudit, fact.auditorSet); err != nil {
+		return err
+	}
+
+	if fact.quota != nil {
+		if err := fact.quota.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.schema != nil {
+		if err := fact.schema.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	if err := common.IsValidOperationFact(fact, b); err != nil {
 		return err
 	}
@@ -196,6 +255,30 @@ func (fact AddTemplateFact) MultiAudit() types.Bool {
 	return fact.multiAudit
 }
 
+func (fact AddTemplateFact) ConsentRequired() types.Bool {
+	return fact.consentRequired
+}
+
+func (fact AddTemplateFact) PrivateValue() types.Bool {
+	return fact.privateValue
+}
+
+func (fact AddTemplateFact) SelectiveDisclosure() types.Bool {
+	return fact.selectiveDisclosure
+}
+
+func (fact AddTemplateFact) AuditorSet() *types.AuditorSet {
+	return fact.auditorSet
+}
+
+func (fact AddTemplateFact) Quota() *types.Quota {
+	return fact.quota
+}
+
+func (fact AddTemplateFact) Schema() *types.CredentialSchema {
+	return fact.schema
+}
+
 func (fact AddTemplateFact) DisplayName() string {
 	return fact.displayName
 }
@@ -235,9 +318,27 @@ func NewAddTemplate(fact AddTemplateFact) (AddTemplate, error) {
 var (
 	MaxLengthTemplateID      = 20
 	MaxLengthCredentialID    = 20
+	MaxLengthBatchID         = 20
 	MaxLengthTemplateName    = 20
 	MaxLengthDisplayName     = 20
 	MaxLengthSubjectKey      = 256
 	MaxLengthCredentialValue = 1024
 	MaxLengthDescription     = 1024
 )
+
+// isValidAuditorSet checks the template is multi-audit if and only if it has
+// the auditor set.
+func isValidAuditorSet(multiAudit types.Bool, auditorSet *types.AuditorSet) error {
+	switch {
+	case auditorSet == nil:
+		if multiAudit {
+			return util.ErrInvalid.Errorf("empty auditor set of multi-audit template")
+		}
+
+		return nil
+	case !bool(multiAudit):
+		return util.ErrInvalid.Errorf("auditor set of not multi-audit template")
+	default:
+		return auditorSet.IsValid(nil)
+	}
+}
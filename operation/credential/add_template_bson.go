@@ -1,6 +1,7 @@
 package credential // nolint: dupl
 
 import (
+	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	"go.mongodb.org/mongo-driver/bson"
 
@@ -11,43 +12,64 @@ import (
 )
 
 func (fact AddTemplateFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":           fact.Hint().String(),
-			"sender":          fact.sender,
-			"contract":        fact.contract,
-			"template_id":     fact.templateID,
-			"template_name":   fact.templateName,
-			"service_date":    fact.serviceDate,
-			"expiration_date": fact.expirationDate,
-			"template_share":  fact.templateShare,
-			"multi_audit":     fact.multiAudit,
-			"display_name":    fact.displayName,
-			"subject_key":     fact.subjectKey,
-			"description":     fact.description,
-			"creator":         fact.creator,
-			"currency":        fact.currency,
-			"hash":            fact.BaseFact.Hash().String(),
-			"token":           fact.BaseFact.Token(),
-		},
-	)
+	m := bson.M{
+		"_hint":                fact.Hint().String(),
+		"sender":               fact.sender,
+		"contract":             fact.contract,
+		"template_id":          fact.templateID,
+		"template_name":        fact.templateName,
+		"service_date":         fact.serviceDate,
+		"expiration_date":      fact.expirationDate,
+		"template_share":       fact.templateShare,
+		"multi_audit":          fact.multiAudit,
+		"consent_required":     fact.consentRequired,
+		"private_value":        fact.privateValue,
+		"selective_disclosure": fact.selectiveDisclosure,
+		"display_name":         fact.displayName,
+		"subject_key":          fact.subjectKey,
+		"description":          fact.description,
+		"creator":              fact.creator,
+		"currency":             fact.currency,
+		"hash":                 fact.BaseFact.Hash().String(),
+		"token":                fact.BaseFact.Token(),
+	}
+
+	if fact.auditorSet != nil {
+		m["auditor_set"] = fact.auditorSet
+	}
+
+	if fact.quota != nil {
+		m["quota"] = fact.quota
+	}
+
+	if fact.schema != nil {
+		m["schema"] = fact.schema
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type AddTemplateFactBSONUnmarshaler struct {
-	Hint           string `bson:"_hint"`
-	Sender         string `bson:"sender"`
-	Contract       string `bson:"contract"`
-	TemplateID     string `bson:"template_id"`
-	TemplateName   string `bson:"template_name"`
-	ServiceDate    string `bson:"service_date"`
-	ExpirationDate string `bson:"expiration_date"`
-	TemplateShare  bool   `bson:"template_share"`
-	MultiAudit     bool   `bson:"multi_audit"`
-	DisplayName    string `bson:"display_name"`
-	SubjectKey     string `bson:"subject_key"`
-	Description    string `bson:"description"`
-	Creator        string `bson:"creator"`
-	Currency       string `bson:"currency"`
+	Hint                string   `bson:"_hint"`
+	Sender              string   `bson:"sender"`
+	Contract            string   `bson:"contract"`
+	TemplateID          string   `bson:"template_id"`
+	TemplateName        string   `bson:"template_name"`
+	ServiceDate         string   `bson:"service_date"`
+	ExpirationDate      string   `bson:"expiration_date"`
+	TemplateShare       bool     `bson:"template_share"`
+	MultiAudit          bool     `bson:"multi_audit"`
+	ConsentRequired     bool     `bson:"consent_required"`
+	PrivateValue        bool     `bson:"private_value"`
+	SelectiveDisclosure bool     `bson:"selective_disclosure"`
+	AuditorSet          bson.Raw `bson:"auditor_set,omitempty"`
+	Quota               bson.Raw `bson:"quota,omitempty"`
+	Schema              bson.Raw `bson:"schema,omitempty"`
+	DisplayName         string   `bson:"display_name"`
+	SubjectKey          string   `bson:"subject_key"`
+	Description         string   `bson:"description"`
+	Creator             string   `bson:"creator"`
+	Currency            string   `bson:"currency"`
 }
 
 func (fact *AddTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -73,6 +95,30 @@ func (fact *AddTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
+	var auditorSet *types.AuditorSet
+	if len(uf.AuditorSet) > 0 {
+		auditorSet = new(types.AuditorSet)
+		if err := auditorSet.DecodeBSON(uf.AuditorSet, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var quota *types.Quota
+	if len(uf.Quota) > 0 {
+		quota = new(types.Quota)
+		if err := quota.DecodeBSON(uf.Quota, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var schema *types.CredentialSchema
+	if len(uf.Schema) > 0 {
+		schema = new(types.CredentialSchema)
+		if err := schema.DecodeBSON(uf.Schema, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return fact.unpack(enc,
 		uf.Sender,
 		uf.Contract,
@@ -82,6 +128,12 @@ func (fact *AddTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uf.ExpirationDate,
 		uf.TemplateShare,
 		uf.MultiAudit,
+		uf.ConsentRequired,
+		uf.PrivateValue,
+		uf.SelectiveDisclosure,
+		auditorSet,
+		quota,
+		schema,
 		uf.DisplayName,
 		uf.SubjectKey,
 		uf.Description,
@@ -11,7 +11,10 @@ import (
 func (fact *AddTemplateFact) unpack(enc encoder.Encoder,
 	sAdr, cAdr, tmplID string,
 	tmplName, svcDate, expDate string,
-	tmplShr, ma bool,
+	tmplShr, ma, cr, pv, sd bool,
+	auditorSet *types.AuditorSet,
+	quota *types.Quota,
+	schema *types.CredentialSchema,
 	dpName, subjKey, desc, crAdr, cid string,
 ) error {
 	e := util.StringError("failed to unmarshal AddTemplateFact")
@@ -21,6 +24,12 @@ func (fact *AddTemplateFact) unpack(enc encoder.Encoder,
 	fact.expirationDate = types.Date(expDate)
 	fact.templateShare = types.Bool(tmplShr)
 	fact.multiAudit = types.Bool(ma)
+	fact.consentRequired = types.Bool(cr)
+	fact.privateValue = types.Bool(pv)
+	fact.selectiveDisclosure = types.Bool(sd)
+	fact.auditorSet = auditorSet
+	fact.quota = quota
+	fact.schema = schema
 	fact.displayName = dpName
 	fact.subjectKey = subjKey
 	fact.description = desc
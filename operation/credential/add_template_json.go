@@ -1,6 +1,8 @@
 package credential
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
@@ -11,19 +13,25 @@ import (
 
 type AddTemplateFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Owner          base.Address             `json:"sender"`
-	Contract       base.Address             `json:"contract"`
-	TemplateID     string                   `json:"template_id"`
-	TemplateName   string                   `json:"template_name"`
-	ServiceDate    types.Date               `json:"service_date"`
-	ExpirationDate types.Date               `json:"expiration_date"`
-	TemplateShare  types.Bool               `json:"template_share"`
-	MultiAudit     types.Bool               `json:"multi_audit"`
-	DisplayName    string                   `json:"display_name"`
-	SubjectKey     string                   `json:"subject_key"`
-	Description    string                   `json:"description"`
-	Creator        base.Address             `json:"creator"`
-	Currency       currencytypes.CurrencyID `json:"currency"`
+	Owner               base.Address             `json:"sender"`
+	Contract            base.Address             `json:"contract"`
+	TemplateID          string                   `json:"template_id"`
+	TemplateName        string                   `json:"template_name"`
+	ServiceDate         types.Date               `json:"service_date"`
+	ExpirationDate      types.Date               `json:"expiration_date"`
+	TemplateShare       types.Bool               `json:"template_share"`
+	MultiAudit          types.Bool               `json:"multi_audit"`
+	ConsentRequired     types.Bool               `json:"consent_required"`
+	PrivateValue        types.Bool               `json:"private_value"`
+	SelectiveDisclosure types.Bool               `json:"selective_disclosure"`
+	AuditorSet          *types.AuditorSet        `json:"auditor_set,omitempty"`
+	Quota               *types.Quota             `json:"quota,omitempty"`
+	Schema              *types.CredentialSchema  `json:"schema,omitempty"`
+	DisplayName         string                   `json:"display_name"`
+	SubjectKey          string                   `json:"subject_key"`
+	Description         string                   `json:"description"`
+	Creator             base.Address             `json:"creator"`
+	Currency            currencytypes.CurrencyID `json:"currency"`
 }
 
 func (fact AddTemplateFact) MarshalJSON() ([]byte, error) {
@@ -37,6 +45,12 @@ func (fact AddTemplateFact) MarshalJSON() ([]byte, error) {
 		ExpirationDate:        fact.expirationDate,
 		TemplateShare:         fact.templateShare,
 		MultiAudit:            fact.multiAudit,
+		ConsentRequired:       fact.consentRequired,
+		PrivateValue:          fact.privateValue,
+		SelectiveDisclosure:   fact.selectiveDisclosure,
+		AuditorSet:            fact.auditorSet,
+		Quota:                 fact.quota,
+		Schema:                fact.schema,
 		DisplayName:           fact.displayName,
 		SubjectKey:            fact.subjectKey,
 		Description:           fact.description,
@@ -47,19 +61,25 @@ func (fact AddTemplateFact) MarshalJSON() ([]byte, error) {
 
 type AddTemplateFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner          string `json:"sender"`
-	Contract       string `json:"contract"`
-	TemplateID     string `json:"template_id"`
-	TemplateName   string `json:"template_name"`
-	ServiceDate    string `json:"service_date"`
-	ExpirationDate string `json:"expiration_date"`
-	TemplateShare  bool   `json:"template_share"`
-	MultiAudit     bool   `json:"multi_audit"`
-	DisplayName    string `json:"display_name"`
-	SubjectKey     string `json:"subject_key"`
-	Description    string `json:"description"`
-	Creator        string `json:"creator"`
-	Currency       string `json:"currency"`
+	Owner               string          `json:"sender"`
+	Contract            string          `json:"contract"`
+	TemplateID          string          `json:"template_id"`
+	TemplateName        string          `json:"template_name"`
+	ServiceDate         string          `json:"service_date"`
+	ExpirationDate      string          `json:"expiration_date"`
+	TemplateShare       bool            `json:"template_share"`
+	MultiAudit          bool            `json:"multi_audit"`
+	ConsentRequired     bool            `json:"consent_required"`
+	PrivateValue        bool            `json:"private_value"`
+	SelectiveDisclosure bool            `json:"selective_disclosure"`
+	AuditorSet          json.RawMessage `json:"auditor_set"`
+	Quota               json.RawMessage `json:"quota"`
+	Schema              json.RawMessage `json:"schema"`
+	DisplayName         string          `json:"display_name"`
+	SubjectKey          string          `json:"subject_key"`
+	Description         string          `json:"description"`
+	Creator             string          `json:"creator"`
+	Currency            string          `json:"currency"`
 }
 
 func (fact *AddTemplateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -72,6 +92,30 @@ func (fact *AddTemplateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
+	var auditorSet *types.AuditorSet
+	if len(uf.AuditorSet) > 0 && string(uf.AuditorSet) != "null" {
+		auditorSet = new(types.AuditorSet)
+		if err := auditorSet.DecodeJSON(uf.AuditorSet, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var quota *types.Quota
+	if len(uf.Quota) > 0 && string(uf.Quota) != "null" {
+		quota = new(types.Quota)
+		if err := quota.DecodeJSON(uf.Quota, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var schema *types.CredentialSchema
+	if len(uf.Schema) > 0 && string(uf.Schema) != "null" {
+		schema = new(types.CredentialSchema)
+		if err := schema.DecodeJSON(uf.Schema, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return fact.unpack(enc,
 		uf.Owner,
 		uf.Contract,
@@ -81,6 +125,12 @@ func (fact *AddTemplateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		uf.ExpirationDate,
 		uf.TemplateShare,
 		uf.MultiAudit,
+		uf.ConsentRequired,
+		uf.PrivateValue,
+		uf.SelectiveDisclosure,
+		auditorSet,
+		quota,
+		schema,
 		uf.DisplayName,
 		uf.SubjectKey,
 		uf.Description,
@@ -2,7 +2,6 @@ package credential
 
 import (
 	"context"
-	"sort"
 	"sync"
 
 	"github.com/ProtoconNet/mitum-credential/state"
@@ -104,8 +103,8 @@ func (opp *AddTemplateProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("contract account value not found from state, %q; %w", fact.Contract(), err), nil
 	}
 
-	if !(ca.Owner().Equal(fact.sender) || ca.IsOperator(fact.Sender())) {
-		return nil, base.NewBaseOperationProcessReasonError("sender account is neither the owner nor the operator of the target contract account, %q", fact.sender), nil
+	if err := checkServiceRole(ca, fact.Contract(), fact.Sender(), types.RoleTemplateAdmin, fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
 	}
 
 	st, err = currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
@@ -118,10 +117,16 @@ func (opp *AddTemplateProcessor) PreProcess(
 		return nil, base.NewBaseOperationProcessReasonError("credential service value not found from state, %s; %w", fact.Contract(), err), nil
 	}
 
-	for _, templateID := range design.Policy().TemplateIDs() {
-		if templateID == fact.TemplateID() {
-			return nil, base.NewBaseOperationProcessReasonError("already registered template, %q, %s", fact.TemplateID(), fact.Contract()), nil
-		}
+	if err := design.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid credential service, %s; %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(state.StateKeyTemplate(fact.Contract(), fact.TemplateID()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("already registered template, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(state.StateKeyTemplateGrant(fact.Contract(), fact.TemplateID()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template already granted by other credential service, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
 	}
 
 	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
@@ -138,24 +143,22 @@ func (opp *AddTemplateProcessor) Process(
 	fact, _ := op.Fact().(AddTemplateFact)
 	st, _ := currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
 	design, _ := state.StateDesignValue(st)
-	templateIDs := design.Policy().TemplateIDs()
-	templateIDs = append(templateIDs, fact.templateID)
-	sort.Slice(templateIDs, func(i int, j int) bool {
-		return templateIDs[i] < templateIDs[j]
-	})
-	policy := types.NewPolicy(templateIDs, design.Policy().Holders(), design.Policy().CredentialCount())
+	policy := types.NewPolicy(
+		design.Policy().TemplateCount()+1, design.Policy().CredentialCount(), design.Policy().HolderCount(),
+		design.Policy().LegacyHolders(),
+	)
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid credential policy, %s; %w", fact.Contract(), err), nil
 	}
 
-	design = types.NewDesign(policy)
+	design = types.NewDesign(policy, design.DIDMethods())
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid credential design, %s; %w", fact.Contract(), err), nil
 	}
 
 	template := types.NewTemplate(
 		fact.TemplateID(), fact.TemplateName(), fact.ServiceDate(), fact.ExpirationDate(),
-		fact.TemplateShare(), fact.MultiAudit(), fact.DisplayName(), fact.SubjectKey(),
+		fact.TemplateShare(), fact.MultiAudit(), fact.ConsentRequired(), fact.PrivateValue(), fact.SelectiveDisclosure(), fact.AuditorSet(), fact.Quota(), fact.Schema(), fact.DisplayName(), fact.SubjectKey(),
 		fact.Description(), fact.Creator(),
 	)
 	if err := template.IsValid(nil); err != nil {
@@ -171,7 +174,7 @@ func (opp *AddTemplateProcessor) Process(
 
 	sts[1] = currencystate.NewStateMergeValue(
 		state.StateKeyTemplate(fact.Contract(), fact.TemplateID()),
-		state.NewTemplateStateValue(template),
+		state.NewTemplateStateValue(template, types.TemplateStatusActive),
 	)
 
 	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
@@ -0,0 +1,163 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	AnchorBatchFactHint = hint.MustNewHint("mitum-credential-anchor-batch-operation-fact-v0.0.1")
+	AnchorBatchHint     = hint.MustNewHint("mitum-credential-anchor-batch-operation-v0.0.1")
+)
+
+// AnchorBatchFact anchors the merkle root of the credentials issued off chain
+// in a batch, instead of assigning each of them.
+type AnchorBatchFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	batchID    string
+	root       string
+	count      uint64
+	currency   currencytypes.CurrencyID
+}
+
+func NewAnchorBatchFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID, batchID string,
+	root string,
+	count uint64,
+	currency currencytypes.CurrencyID,
+) AnchorBatchFact {
+	bf := base.NewBaseFact(AnchorBatchFactHint, token)
+	fact := AnchorBatchFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		batchID:    batchID,
+		root:       root,
+		count:      count,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact AnchorBatchFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact AnchorBatchFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact AnchorBatchFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.batchID),
+		[]byte(fact.root),
+		util.Uint64ToBytes(fact.count),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact AnchorBatchFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(fact.batchID); l < 1 || l > MaxLengthBatchID {
+		return util.ErrInvalid.Errorf("invalid length of batch ID, 0 <= length <= %d", MaxLengthBatchID)
+	}
+
+	if err := types.IsValidMerkleRoot(fact.root); err != nil {
+		return err
+	}
+
+	if fact.count < 1 {
+		return util.ErrInvalid.Errorf("empty batch")
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact AnchorBatchFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact AnchorBatchFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact AnchorBatchFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact AnchorBatchFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact AnchorBatchFact) BatchID() string {
+	return fact.batchID
+}
+
+// Root is the hex encoded merkle root of types.CredentialLeaf of the
+// credentials.
+func (fact AnchorBatchFact) Root() string {
+	return fact.root
+}
+
+func (fact AnchorBatchFact) Count() uint64 {
+	return fact.count
+}
+
+func (fact AnchorBatchFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact AnchorBatchFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type AnchorBatch struct {
+	common.BaseOperation
+}
+
+func NewAnchorBatch(fact AnchorBatchFact) (AnchorBatch, error) {
+	return AnchorBatch{BaseOperation: common.NewBaseOperation(AnchorBatchHint, fact)}, nil
+}
@@ -0,0 +1,38 @@
+package credential
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *AnchorBatchFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, templateID, batchID, root string,
+	count uint64,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal AnchorBatchFact")
+
+	fact.templateID = templateID
+	fact.batchID = batchID
+	fact.root = root
+	fact.count = count
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
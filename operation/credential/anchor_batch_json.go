@@ -0,0 +1,88 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type AnchorBatchFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	BatchID    string                   `json:"batch_id"`
+	Root       string                   `json:"root"`
+	Count      uint64                   `json:"count"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact AnchorBatchFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AnchorBatchFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		BatchID:               fact.batchID,
+		Root:                  fact.root,
+		Count:                 fact.count,
+		Currency:              fact.currency,
+	})
+}
+
+type AnchorBatchFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	BatchID    string `json:"batch_id"`
+	Root       string `json:"root"`
+	Count      uint64 `json:"count"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *AnchorBatchFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of AnchorBatchFact")
+
+	var uf AnchorBatchFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.BatchID,
+		uf.Root,
+		uf.Count,
+		uf.Currency,
+	)
+}
+
+type AnchorBatchMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op AnchorBatch) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AnchorBatchMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *AnchorBatch) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of AnchorBatch")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,235 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var anchorBatchProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(AnchorBatchProcessor)
+	},
+}
+
+func (AnchorBatch) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type AnchorBatchProcessor struct {
+	*base.BaseOperationProcessor
+	proposedAt ProposedAtFunc
+}
+
+func NewAnchorBatchProcessor(proposedAt ProposedAtFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new AnchorBatchProcessor")
+
+		nopp := anchorBatchProcessorPool.Get()
+		opp, ok := nopp.(*AnchorBatchProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected AnchorBatchProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposedAt = proposedAt
+
+		return opp, nil
+	}
+}
+
+func (opp *AnchorBatchProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess AnchorBatch")
+
+	fact, ok := op.Fact().(AnchorBatchFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", AnchorBatchFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found from state, %q; %w", fact.Contract(), err), nil
+	}
+
+	if err := checkServiceRole(ca, fact.Contract(), fact.Sender(), types.RoleIssuer, fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	st, owner, err := existsTemplateState(fact.Contract(), fact.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("templateID not found, %q; %w", fact.TemplateID(), err), nil
+	}
+
+	switch status, err := state.StateTemplateStatusValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("template status not found from state, %q; %w", fact.TemplateID(), err), nil
+	case !status.IsAssignable():
+		return nil, base.NewBaseOperationProcessReasonError("template is %s, %q", status, fact.TemplateID()), nil
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template value not found from state, %q; %w", fact.TemplateID(), err), nil
+	}
+
+	if !owner.Equal(fact.Contract()) && !bool(template.TemplateShare()) {
+		return nil, base.NewBaseOperationProcessReasonError("template no longer shared by %s, %q", owner, fact.TemplateID()), nil
+	}
+
+	proposedAt, err := opp.proposedAt(opp.Height())
+	if err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := checkTemplateInService(template, proposedAt); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	// NOTE the audit, the consent of holder and the quota per holder are
+	// checked with each credential, which is not on chain in the batch.
+	switch {
+	case bool(template.MultiAudit()):
+		return nil, base.NewBaseOperationProcessReasonError("batch of multi-audit template not allowed, %q", fact.TemplateID()), nil
+	case bool(template.ConsentRequired()):
+		return nil, base.NewBaseOperationProcessReasonError("batch of consent required template not allowed, %q", fact.TemplateID()), nil
+	case template.Quota() != nil && template.Quota().MaxPerHolder() > 0:
+		return nil, base.NewBaseOperationProcessReasonError("batch of template with quota per holder not allowed, %q", fact.TemplateID()), nil
+	}
+
+	if quota := template.Quota(); quota != nil && quota.MaxSupply() > 0 {
+		supply, err := templateStat(state.StateKeyTemplateStat(owner, fact.TemplateID()), map[string]*uint64{}, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		if *supply+fact.Count() > quota.MaxSupply() {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"max supply of template exceeded by batch, %q; %d + %d > %d",
+				fact.TemplateID(), *supply, fact.Count(), quota.MaxSupply(),
+			), nil
+		}
+	}
+
+	if err := currencystate.CheckNotExistsState(state.StateKeyBatchAnchor(fact.Contract(), fact.TemplateID(), fact.BatchID()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("batch already anchored, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.BatchID(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *AnchorBatchProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(AnchorBatchFact)
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyBatchAnchor(fact.Contract(), fact.TemplateID(), fact.BatchID()),
+			state.NewBatchAnchorStateValue(fact.Root(), fact.Count()),
+		),
+	}
+
+	owner, err := templateOwner(fact.Contract(), fact.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	k := state.StateKeyTemplateStat(owner, fact.TemplateID())
+
+	supply, err := templateStat(k, map[string]*uint64{}, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	sts = append(sts, currencystate.NewStateMergeValue(k, state.NewTemplateStatStateValue(*supply+fact.Count())))
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts = append(sts, currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+
+	return sts, nil, nil
+}
+
+func (opp *AnchorBatchProcessor) Close() error {
+	anchorBatchProcessorPool.Put(opp)
+
+	return nil
+}
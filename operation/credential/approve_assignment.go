@@ -0,0 +1,136 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	ApproveAssignmentFactHint = hint.MustNewHint("mitum-credential-approve-assignment-operation-fact-v0.0.1")
+	ApproveAssignmentHint     = hint.MustNewHint("mitum-credential-approve-assignment-operation-v0.0.1")
+)
+
+// ApproveAssignmentFact approves the pending assignment of credential by the
+// sender, an auditor of the multi-audit template.
+type ApproveAssignmentFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	id         string
+	currency   currencytypes.CurrencyID
+}
+
+func NewApproveAssignmentFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID, id string,
+	currency currencytypes.CurrencyID,
+) ApproveAssignmentFact {
+	bf := base.NewBaseFact(ApproveAssignmentFactHint, token)
+	fact := ApproveAssignmentFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		id:         id,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact ApproveAssignmentFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact ApproveAssignmentFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact ApproveAssignmentFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.id),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact ApproveAssignmentFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(fact.id); l < 1 || l > MaxLengthCredentialID {
+		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact ApproveAssignmentFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact ApproveAssignmentFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact ApproveAssignmentFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact ApproveAssignmentFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact ApproveAssignmentFact) ID() string {
+	return fact.id
+}
+
+func (fact ApproveAssignmentFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact ApproveAssignmentFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type ApproveAssignment struct {
+	common.BaseOperation
+}
+
+func NewApproveAssignment(fact ApproveAssignmentFact) (ApproveAssignment, error) {
+	return ApproveAssignment{BaseOperation: common.NewBaseOperation(ApproveAssignmentHint, fact)}, nil
+}
@@ -0,0 +1,80 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ApproveAssignmentFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	ID         string                   `json:"id"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact ApproveAssignmentFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveAssignmentFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		ID:                    fact.id,
+		Currency:              fact.currency,
+	})
+}
+
+type ApproveAssignmentFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	ID         string `json:"id"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *ApproveAssignmentFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveAssignmentFact")
+
+	var uf ApproveAssignmentFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.ID,
+		uf.Currency,
+	)
+}
+
+type ApproveAssignmentMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op ApproveAssignment) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ApproveAssignmentMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *ApproveAssignment) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ApproveAssignment")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,342 @@
+package credential
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var approveAssignmentProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(ApproveAssignmentProcessor)
+	},
+}
+
+func (ApproveAssignment) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type ApproveAssignmentProcessor struct {
+	*base.BaseOperationProcessor
+	proposedAt ProposedAtFunc
+}
+
+func NewApproveAssignmentProcessor(proposedAt ProposedAtFunc) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new ApproveAssignmentProcessor")
+
+		nopp := approveAssignmentProcessorPool.Get()
+		opp, ok := nopp.(*ApproveAssignmentProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected ApproveAssignmentProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposedAt = proposedAt
+
+		return opp, nil
+	}
+}
+
+func (opp *ApproveAssignmentProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess ApproveAssignment")
+
+	fact, ok := op.Fact().(ApproveAssignmentFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", ApproveAssignmentFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	st, _, err := existsTemplateState(fact.Contract(), fact.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("templateID not found, %q; %w", fact.TemplateID(), err), nil
+	}
+
+	switch status, err := state.StateTemplateStatusValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("template status not found from state, %q; %w", fact.TemplateID(), err), nil
+	case !status.IsAssignable():
+		return nil, base.NewBaseOperationProcessReasonError("template is %s, %q", status, fact.TemplateID()), nil
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template value not found from state, %q; %w", fact.TemplateID(), err), nil
+	}
+
+	as := template.AuditorSet()
+	if as == nil {
+		return nil, base.NewBaseOperationProcessReasonError("auditor set not found in template, %q", fact.TemplateID()), nil
+	}
+
+	if !as.IsAuditor(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not auditor of template, %q, %q", fact.Sender(), fact.TemplateID()), nil
+	}
+
+	st, err = currencystate.ExistsState(
+		state.StateKeyPendingAssignment(fact.Contract(), fact.TemplateID(), fact.ID()), "key of pending assignment", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("pending assignment not found, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.ID(), err), nil
+	}
+
+	pa, err := state.StatePendingAssignmentValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("pending assignment value not found from state, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.ID(), err), nil
+	}
+
+	switch {
+	case pa.Assigner.Equal(fact.Sender()):
+		return nil, base.NewBaseOperationProcessReasonError("assignment can not be approved by assigner, %s-%s-%s, %q", fact.Contract(), fact.TemplateID(), fact.ID(), fact.Sender()), nil
+	case pa.IsApproved():
+		return nil, base.NewBaseOperationProcessReasonError("assignment already approved, %s-%s-%s", fact.Contract(), fact.TemplateID(), fact.ID()), nil
+	case pa.HasApproved(fact.Sender()):
+		return nil, base.NewBaseOperationProcessReasonError("assignment already approved by sender, %s-%s-%s, %q", fact.Contract(), fact.TemplateID(), fact.ID(), fact.Sender()), nil
+	}
+
+	if uint(len(pa.Approvals))+1 >= pa.Threshold {
+		proposedAt, err := opp.proposedAt(opp.Height())
+		if err != nil {
+			return ctx, nil, e.Wrap(err)
+		}
+
+		if err := checkActivation(fact.Contract(), template, pa.Credential, proposedAt, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+
+		if err := checkHolderDID(fact.Contract(), pa.Credential.Holder(), pa.Credential.DID(), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+		}
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *ApproveAssignmentProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(ApproveAssignmentFact)
+
+	k := state.StateKeyPendingAssignment(fact.Contract(), fact.TemplateID(), fact.ID())
+
+	st, _ := currencystate.ExistsState(k, "key of pending assignment", getStateFunc)
+	pa, err := state.StatePendingAssignmentValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("pending assignment value not found from state, %s; %w", k, err), nil
+	}
+
+	approvals := make([]base.Address, len(pa.Approvals)+1)
+	copy(approvals, pa.Approvals)
+	approvals[len(pa.Approvals)] = fact.Sender()
+
+	issuerSign := pa.IssuerSign
+	pa = state.NewPendingAssignmentStateValue(pa.Credential, pa.Assigner, pa.Threshold, approvals)
+	pa.IssuerSign = issuerSign
+
+	sts := []base.StateMergeValue{currencystate.NewStateMergeValue(k, pa)}
+
+	if pa.IsApproved() {
+		proposedAt, err := opp.proposedAt(opp.Height())
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "failed to process ApproveAssignment")
+		}
+
+		asts, err := activatePendingAssignment(fact.Contract(), pa.Credential, pa.IssuerSign, proposedAt, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to activate credential, %s; %w", k, err), nil
+		}
+
+		sts = append(sts, asts...)
+	}
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts = append(sts, currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+
+	return sts, nil, nil
+}
+
+func (opp *ApproveAssignmentProcessor) Close() error {
+	opp.proposedAt = nil
+
+	approveAssignmentProcessorPool.Put(opp)
+
+	return nil
+}
+
+// activatePendingAssignment assigns the approved credential like Assign does
+// for the templates without multi audit.
+func activatePendingAssignment(
+	contract base.Address,
+	credential types.Credential,
+	issuerSign *types.IssuerSign,
+	proposedAt time.Time,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	k := state.StateKeyDesign(contract)
+
+	st, err := currencystate.ExistsState(k, "key of design", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	de, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	st, _, err = existsTemplateState(contract, credential.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkActivation(contract, template, credential, proposedAt, getStateFunc); err != nil {
+		return nil, err
+	}
+
+	credentialCount := de.Policy().CredentialCount()
+	holderCount := de.Policy().HolderCount()
+	holderStats := map[string]*uint64{}
+	templateStats := map[string]*uint64{}
+	statusLists := newStatusListChunks()
+
+	sts, err := assignCredential(
+		contract, credential, issuerSign, template.Quota(),
+		&credentialCount, &holderCount, holderStats, templateStats, statusLists,
+		getStateFunc,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	design := types.NewDesign(types.NewPolicy(de.Policy().TemplateCount(), credentialCount, holderCount, de.Policy().LegacyHolders()), de.DIDMethods())
+	if err := design.IsValid(nil); err != nil {
+		return nil, errors.Wrapf(err, "invalid design, %s", k)
+	}
+
+	sts = append(sts, currencystate.NewStateMergeValue(k, state.NewDesignStateValue(design)))
+
+	for k, count := range holderStats {
+		sts = append(sts, currencystate.NewStateMergeValue(k, state.NewHolderStatStateValue(*count)))
+	}
+
+	for k, count := range templateStats {
+		sts = append(sts, currencystate.NewStateMergeValue(k, state.NewTemplateStatStateValue(*count)))
+	}
+
+	sts = append(sts, statusLists.states()...)
+
+	return sts, nil
+}
+
+// checkActivation checks the pending credential can still be assigned when
+// the approvals reach the threshold; the template, the credential service and
+// the credential may be changed after the assignment.
+func checkActivation(
+	contract base.Address,
+	template types.Template,
+	credential types.Credential,
+	proposedAt time.Time,
+	getStateFunc base.GetStateFunc,
+) error {
+	if err := checkTemplateServicePeriod(template, proposedAt, credential.ValidFrom(), credential.ValidUntil()); err != nil {
+		return err
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyDesign(contract), "key of design", getStateFunc)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get design state of credential service")
+	}
+
+	de, err := state.StateDesignValue(st)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get design value of credential service from state")
+	}
+
+	if m := types.DID(credential.DID()).Method(); !de.IsAllowedDIDMethod(m) {
+		return errors.Errorf("did method not allowed by credential service, %q", m)
+	}
+
+	return checkCredentialIDNotUsed(contract, credential.TemplateID(), credential.ID(), getStateFunc)
+}
@@ -80,6 +80,8 @@ func (fact AssignFact) IsValid(b []byte) error {
 	}
 
 	founds := map[string]struct{}{}
+	holderDIDs := map[string]string{}
+	didHolders := map[string]string{}
 	for _, it := range fact.items {
 		if err := it.IsValid(nil); err != nil {
 			return err
@@ -96,6 +98,18 @@ func (fact AssignFact) IsValid(b []byte) error {
 		}
 
 		founds[k] = struct{}{}
+
+		hk := fmt.Sprintf("%s-%s", it.contract, it.holder)
+		if d, found := holderDIDs[hk]; found && d != it.did {
+			return util.ErrInvalid.Errorf("different dids for the same holder, %s", hk)
+		}
+		holderDIDs[hk] = it.did
+
+		dk := fmt.Sprintf("%s-%s", it.contract, it.did)
+		if h, found := didHolders[dk]; found && h != it.holder.String() {
+			return util.ErrInvalid.Errorf("same did for different holders, %s", dk)
+		}
+		didHolders[dk] = it.holder.String()
 	}
 
 	if err := common.IsValidOperationFact(fact, b); err != nil {
@@ -3,6 +3,7 @@ package credential
 import (
 	"unicode/utf8"
 
+	"github.com/ProtoconNet/mitum-credential/types"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -11,17 +12,21 @@ import (
 
 var AssignItemHint = hint.MustNewHint("mitum-credential-assign-item-v0.0.1")
 
+var consentBytesPrefix = []byte("mitum-credential-assign-consent")
+
 type AssignItem struct {
 	hint.BaseHinter
-	contract   base.Address
-	holder     base.Address
-	templateID string
-	id         string
-	value      string
-	validFrom  uint64
-	validUntil uint64
-	did        string
-	currency   currencytypes.CurrencyID
+	contract    base.Address
+	holder      base.Address
+	templateID  string
+	id          string
+	value       string
+	validFrom   uint64
+	validUntil  uint64
+	did         string
+	holderSigns []base.BaseSign
+	issuerSign  *types.IssuerSign
+	currency    currencytypes.CurrencyID
 }
 
 func NewAssignItem(
@@ -33,23 +38,54 @@ func NewAssignItem(
 	validFrom uint64,
 	validUntil uint64,
 	did string,
+	holderSigns []base.BaseSign,
+	issuerSign *types.IssuerSign,
 	currency currencytypes.CurrencyID,
 ) AssignItem {
 	return AssignItem{
-		BaseHinter: hint.NewBaseHinter(AssignItemHint),
-		contract:   contract,
-		holder:     holder,
-		templateID: templateID,
-		id:         id,
-		value:      value,
-		validFrom:  validFrom,
-		validUntil: validUntil,
-		did:        did,
-		currency:   currency,
+		BaseHinter:  hint.NewBaseHinter(AssignItemHint),
+		contract:    contract,
+		holder:      holder,
+		templateID:  templateID,
+		id:          id,
+		value:       value,
+		validFrom:   validFrom,
+		validUntil:  validUntil,
+		did:         did,
+		holderSigns: holderSigns,
+		issuerSign:  issuerSign,
+		currency:    currency,
 	}
 }
 
 func (it AssignItem) Bytes() []byte {
+	bs := make([][]byte, len(it.holderSigns)+2)
+	bs[0] = it.itemBytes()
+
+	for i := range it.holderSigns {
+		bs[i+1] = it.holderSigns[i].Bytes()
+	}
+
+	if it.issuerSign != nil {
+		bs[len(bs)-1] = it.issuerSign.Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// ConsentBytes returns the bytes which the holder signs for the consent to
+// the version of the credential; the holder signs are not included. The
+// credential ids are not assigned again, so the consent is always to the
+// first version, 0, and can not be replayed to the other assignment.
+func (it AssignItem) ConsentBytes(version uint64) []byte {
+	return util.ConcatBytesSlice(
+		consentBytesPrefix,
+		it.itemBytes(),
+		util.Uint64ToBytes(version),
+	)
+}
+
+func (it AssignItem) itemBytes() []byte {
 	return util.ConcatBytesSlice(
 		it.contract.Bytes(),
 		it.holder.Bytes(),
@@ -89,14 +125,34 @@ func (it AssignItem) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
 	}
 
-	if len(it.did) == 0 {
-		return util.ErrInvalid.Errorf("empty did")
+	if err := types.DID(it.did).IsValid(nil); err != nil {
+		return err
 	}
 
 	if l := utf8.RuneCountInString(it.value); l < 1 || l > MaxLengthCredentialValue {
 		return util.ErrInvalid.Errorf("invalid length of value, 0 <= length <= %d", MaxLengthCredentialValue)
 	}
 
+	signers := map[string]struct{}{}
+	for i := range it.holderSigns {
+		if err := it.holderSigns[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		k := it.holderSigns[i].Signer().String()
+		if _, found := signers[k]; found {
+			return util.ErrInvalid.Errorf("duplicate holder signer, %q", k)
+		}
+
+		signers[k] = struct{}{}
+	}
+
+	if it.issuerSign != nil {
+		if err := it.issuerSign.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -132,6 +188,19 @@ func (it AssignItem) DID() string {
 	return it.did
 }
 
+func (it AssignItem) HolderSigns() []base.BaseSign {
+	return it.holderSigns
+}
+
+func (it AssignItem) IssuerSign() *types.IssuerSign {
+	return it.issuerSign
+}
+
+// Credential returns the credential assigned by the item.
+func (it AssignItem) Credential() types.Credential {
+	return types.NewCredential(it.holder, it.templateID, it.id, it.value, it.validFrom, it.validUntil, it.did)
+}
+
 func (it AssignItem) Currency() currencytypes.CurrencyID {
 	return it.currency
 }
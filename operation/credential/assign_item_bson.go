@@ -1,6 +1,7 @@
 package credential // nolint:dupl
 
 import (
+	"github.com/ProtoconNet/mitum-credential/types"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -8,33 +9,48 @@ import (
 )
 
 func (it AssignItem) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":       it.Hint().String(),
-			"contract":    it.contract,
-			"holder":      it.holder,
-			"template_id": it.templateID,
-			"id":          it.id,
-			"value":       it.value,
-			"valid_from":  it.validFrom,
-			"valid_until": it.validUntil,
-			"did":         it.did,
-			"currency":    it.currency,
-		},
-	)
+	m := bson.M{
+		"_hint":       it.Hint().String(),
+		"contract":    it.contract,
+		"holder":      it.holder,
+		"template_id": it.templateID,
+		"id":          it.id,
+		"value":       it.value,
+		"valid_from":  it.validFrom,
+		"valid_until": it.validUntil,
+		"did":         it.did,
+		"currency":    it.currency,
+	}
+
+	if len(it.holderSigns) > 0 {
+		signs := make([]holderSignUnpacker, len(it.holderSigns))
+		for i := range it.holderSigns {
+			signs[i] = newHolderSignUnpacker(it.holderSigns[i])
+		}
+
+		m["holder_signs"] = signs
+	}
+
+	if it.issuerSign != nil {
+		m["issuer_sign"] = it.issuerSign
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type AssignItemBSONUnmarshaler struct {
-	Hint       string `bson:"_hint"`
-	Contract   string `bson:"contract"`
-	Holder     string `bson:"holder"`
-	TemplateID string `bson:"template_id"`
-	ID         string `bson:"id"`
-	Value      string `bson:"value"`
-	ValidFrom  uint64 `bson:"valid_from"`
-	ValidUntil uint64 `bson:"valid_until"`
-	DID        string `bson:"did"`
-	Currency   string `bson:"currency"`
+	Hint        string               `bson:"_hint"`
+	Contract    string               `bson:"contract"`
+	Holder      string               `bson:"holder"`
+	TemplateID  string               `bson:"template_id"`
+	ID          string               `bson:"id"`
+	Value       string               `bson:"value"`
+	ValidFrom   uint64               `bson:"valid_from"`
+	ValidUntil  uint64               `bson:"valid_until"`
+	DID         string               `bson:"did"`
+	HolderSigns []holderSignUnpacker `bson:"holder_signs"`
+	IssuerSign  bson.Raw             `bson:"issuer_sign,omitempty"`
+	Currency    string               `bson:"currency"`
 }
 
 func (it *AssignItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -50,6 +66,14 @@ func (it *AssignItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
+	var issuerSign *types.IssuerSign
+	if len(uit.IssuerSign) > 0 {
+		issuerSign = new(types.IssuerSign)
+		if err := issuerSign.DecodeBSON(uit.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return it.unpack(enc, ht,
 		uit.Contract,
 		uit.Holder,
@@ -59,6 +83,8 @@ func (it *AssignItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uit.ValidFrom,
 		uit.ValidUntil,
 		uit.DID,
+		uit.HolderSigns,
+		issuerSign,
 		uit.Currency,
 	)
 }
@@ -1,11 +1,13 @@
 package credential
 
 import (
+	"github.com/ProtoconNet/mitum-credential/types"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
 )
 
 func (it *AssignItem) unpack(enc encoder.Encoder, ht hint.Hint,
@@ -13,7 +15,10 @@ func (it *AssignItem) unpack(enc encoder.Encoder, ht hint.Hint,
 	id string,
 	val string,
 	vFrom, vUntil uint64,
-	did, cid string,
+	did string,
+	holderSigns []holderSignUnpacker,
+	issuerSign *types.IssuerSign,
+	cid string,
 ) error {
 	e := util.StringError("failed to unmarshal AssignItem")
 
@@ -21,6 +26,7 @@ func (it *AssignItem) unpack(enc encoder.Encoder, ht hint.Hint,
 	it.id = id
 	it.value = val
 	it.did = did
+	it.issuerSign = issuerSign
 	it.currency = currencytypes.CurrencyID(cid)
 
 	switch a, err := base.DecodeAddress(cAdr, enc); {
@@ -41,5 +47,53 @@ func (it *AssignItem) unpack(enc encoder.Encoder, ht hint.Hint,
 	it.validFrom = vFrom
 	it.validUntil = vUntil
 
+	if len(holderSigns) > 0 {
+		it.holderSigns = make([]base.BaseSign, len(holderSigns))
+	}
+
+	for i := range holderSigns {
+		sign, err := holderSigns[i].sign(enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		it.holderSigns[i] = sign
+	}
+
 	return nil
 }
+
+// holderSignUnpacker is the encoded BaseSign of the holder signs, which is
+// same in json and bson.
+type holderSignUnpacker struct {
+	Signer    string `json:"signer" bson:"signer"`
+	Signature string `json:"signature" bson:"signature"`
+	SignedAt  string `json:"signed_at" bson:"signed_at"`
+}
+
+func newHolderSignUnpacker(sign base.BaseSign) holderSignUnpacker {
+	return holderSignUnpacker{
+		Signer:    sign.Signer().String(),
+		Signature: sign.Signature().String(),
+		SignedAt:  localtime.New(sign.SignedAt()).Normalize().RFC3339(),
+	}
+}
+
+func (u holderSignUnpacker) sign(enc encoder.Encoder) (base.BaseSign, error) {
+	signer, err := base.DecodePublickeyFromString(u.Signer, enc)
+	if err != nil {
+		return base.BaseSign{}, err
+	}
+
+	var signature base.Signature
+	if err := signature.UnmarshalText([]byte(u.Signature)); err != nil {
+		return base.BaseSign{}, err
+	}
+
+	var signedAt localtime.Time
+	if err := signedAt.UnmarshalText([]byte(u.SignedAt)); err != nil {
+		return base.BaseSign{}, err
+	}
+
+	return base.NewBaseSign(signer, signature, signedAt.Time), nil
+}
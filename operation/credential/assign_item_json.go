@@ -1,6 +1,9 @@
 package credential
 
 import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -10,43 +13,49 @@ import (
 
 type AssignItemJSONMarshaler struct {
 	hint.BaseHinter
-	Contract   base.Address             `json:"contract"`
-	Holder     base.Address             `json:"holder"`
-	TemplateID string                   `json:"template_id"`
-	ID         string                   `json:"id"`
-	Value      string                   `json:"value"`
-	ValidFrom  uint64                   `json:"valid_from"`
-	ValidUntil uint64                   `json:"valid_until"`
-	DID        string                   `json:"did"`
-	Currency   currencytypes.CurrencyID `json:"currency"`
+	Contract    base.Address             `json:"contract"`
+	Holder      base.Address             `json:"holder"`
+	TemplateID  string                   `json:"template_id"`
+	ID          string                   `json:"id"`
+	Value       string                   `json:"value"`
+	ValidFrom   uint64                   `json:"valid_from"`
+	ValidUntil  uint64                   `json:"valid_until"`
+	DID         string                   `json:"did"`
+	HolderSigns []base.BaseSign          `json:"holder_signs,omitempty"`
+	IssuerSign  *types.IssuerSign        `json:"issuer_sign,omitempty"`
+	Currency    currencytypes.CurrencyID `json:"currency"`
 }
 
 func (it AssignItem) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(AssignItemJSONMarshaler{
-		BaseHinter: it.BaseHinter,
-		Contract:   it.contract,
-		Holder:     it.holder,
-		TemplateID: it.templateID,
-		ID:         it.id,
-		Value:      it.value,
-		ValidFrom:  it.validFrom,
-		ValidUntil: it.validUntil,
-		DID:        it.did,
-		Currency:   it.currency,
+		BaseHinter:  it.BaseHinter,
+		Contract:    it.contract,
+		Holder:      it.holder,
+		TemplateID:  it.templateID,
+		ID:          it.id,
+		Value:       it.value,
+		ValidFrom:   it.validFrom,
+		ValidUntil:  it.validUntil,
+		DID:         it.did,
+		HolderSigns: it.holderSigns,
+		IssuerSign:  it.issuerSign,
+		Currency:    it.currency,
 	})
 }
 
 type AssignItemJSONUnMarshaler struct {
-	Hint       hint.Hint `json:"_hint"`
-	Contract   string    `json:"contract"`
-	Holder     string    `json:"holder"`
-	TemplateID string    `json:"template_id"`
-	ID         string    `json:"id"`
-	Value      string    `json:"value"`
-	ValidFrom  uint64    `json:"valid_from"`
-	ValidUntil uint64    `json:"valid_until"`
-	DID        string    `json:"did"`
-	Currency   string    `json:"currency"`
+	Hint        hint.Hint            `json:"_hint"`
+	Contract    string               `json:"contract"`
+	Holder      string               `json:"holder"`
+	TemplateID  string               `json:"template_id"`
+	ID          string               `json:"id"`
+	Value       string               `json:"value"`
+	ValidFrom   uint64               `json:"valid_from"`
+	ValidUntil  uint64               `json:"valid_until"`
+	DID         string               `json:"did"`
+	HolderSigns []holderSignUnpacker `json:"holder_signs"`
+	IssuerSign  json.RawMessage      `json:"issuer_sign"`
+	Currency    string               `json:"currency"`
 }
 
 func (it *AssignItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -57,6 +66,14 @@ func (it *AssignItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
+	var issuerSign *types.IssuerSign
+	if len(uit.IssuerSign) > 0 && string(uit.IssuerSign) != "null" {
+		issuerSign = new(types.IssuerSign)
+		if err := issuerSign.DecodeJSON(uit.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return it.unpack(enc,
 		uit.Hint,
 		uit.Contract,
@@ -67,6 +84,8 @@ func (it *AssignItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		uit.ValidFrom,
 		uit.ValidUntil,
 		uit.DID,
+		uit.HolderSigns,
+		issuerSign,
 		uit.Currency,
 	)
 }
@@ -3,6 +3,7 @@ package credential
 import (
 	"context"
 	"sync"
+	"time"
 
 	"github.com/ProtoconNet/mitum-credential/state"
 	"github.com/ProtoconNet/mitum-credential/types"
@@ -35,12 +36,21 @@ func (Assign) Process(
 	return nil, nil, nil
 }
 
+// ProposedAtFunc returns the time which is agreed by consensus for the
+// operations of the given height.
+type ProposedAtFunc func(base.Height) (time.Time, error)
+
 type AssignItemProcessor struct {
 	h               util.Hash
 	sender          base.Address
 	item            AssignItem
+	proposedAt      time.Time
+	networkID       base.NetworkID
 	credentialCount *uint64
-	holders         *[]types.Holder
+	holderCount     *uint64
+	holderStats     map[string]*uint64
+	templateStats   map[string]*uint64
+	statusLists     *statusListChunks
 }
 
 func (ipp *AssignItemProcessor) PreProcess(
@@ -74,11 +84,14 @@ func (ipp *AssignItemProcessor) PreProcess(
 		return errors.Wrap(err, "failed to get contract account value from state")
 	}
 
-	if !(ca.Owner().Equal(ipp.sender) || ca.IsOperator(ipp.sender)) {
-		return errors.Errorf(
-			"sender is neither the owner nor the operator of the target contract account, %q",
-			ipp.sender,
-		)
+	if err := checkServiceRole(ca, it.Contract(), ipp.sender, types.RoleIssuer, it.TemplateID(), getStateFunc); err != nil {
+		return err
+	}
+
+	if sign := it.IssuerSign(); sign != nil {
+		if err := checkIssuerSign(ca, it.Contract(), it.Credential(), *sign, ipp.networkID, getStateFunc); err != nil {
+			return errors.WithMessagef(err, "invalid issuer sign, %q", it.ID())
+		}
 	}
 
 	if st, err := currencystate.ExistsState(state.StateKeyDesign(it.Contract()), "key of design", getStateFunc); err != nil {
@@ -89,30 +102,86 @@ func (ipp *AssignItemProcessor) PreProcess(
 		if err := de.IsValid(nil); err != nil {
 			return err
 		}
-		for i, v := range de.Policy().TemplateIDs() {
-			if it.templateID == v {
-				break
-			}
-			if i == len(de.Policy().TemplateIDs())-1 {
-				return errors.Errorf("templateID not found")
-			}
+
+		if m := types.DID(it.DID()).Method(); !de.IsAllowedDIDMethod(m) {
+			return errors.Errorf("did method not allowed by credential service, %q", m)
 		}
 	}
 
-	switch st, found, err := getStateFunc(state.StateKeyCredential(it.Contract(),
-		it.TemplateID(),
-		it.ID())); {
+	st, owner, err := existsTemplateState(it.Contract(), it.TemplateID(), getStateFunc)
+	if err != nil {
+		return errors.Wrapf(err, "templateID not found, %q", it.TemplateID())
+	}
+
+	switch status, err := state.StateTemplateStatusValue(st); {
 	case err != nil:
-		return errors.Wrapf(err, "failed to get credential state")
-	case !found:
-	default:
-		if credential, isActive, err := state.StateCredentialValue(st); err != nil {
-			return errors.Wrapf(err, "failed to get credential state")
-		} else if isActive {
-			return errors.Errorf(
-				"credential already assigned to holder account, %q",
-				credential.Holder(),
-			)
+		return errors.Wrapf(err, "failed to get template status from state, %q", it.TemplateID())
+	case !status.IsAssignable():
+		return errors.Errorf("template is %s, %q", status, it.TemplateID())
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get template value from state, %q", it.TemplateID())
+	}
+
+	if !owner.Equal(it.Contract()) && !bool(template.TemplateShare()) {
+		return errors.Errorf("template no longer shared by %s, %q", owner, it.TemplateID())
+	}
+
+	if err := checkTemplateServicePeriod(template, ipp.proposedAt, it.ValidFrom(), it.ValidUntil()); err != nil {
+		return err
+	}
+
+	if template.MultiAudit() && template.AuditorSet() == nil {
+		return errors.Errorf("auditor set not found in multi-audit template, %q", it.TemplateID())
+	}
+
+	if err := checkCredentialValue(template, it.Value()); err != nil {
+		return errors.WithMessagef(err, "invalid value for template, %q", it.TemplateID())
+	}
+
+	if err := checkHolderDID(it.Contract(), it.Holder(), it.DID(), getStateFunc); err != nil {
+		return err
+	}
+
+	if err := checkCredentialIDNotUsed(it.Contract(), it.TemplateID(), it.ID(), getStateFunc); err != nil {
+		return err
+	}
+
+	// NOTE the credential id is not used, so the consent is to the first
+	// version of the credential.
+	switch {
+	case len(it.HolderSigns()) > 0:
+		if err := checkHolderSigns(it, 0, ipp.networkID, getStateFunc); err != nil {
+			return errors.Wrapf(err, "invalid consent of holder, %q", it.Holder())
+		}
+	case bool(template.ConsentRequired()):
+		return errors.Errorf("consent of holder required by template, %q", it.TemplateID())
+	}
+
+	// NOTE the pending assignments left by a template which is no longer
+	// multi-audit can not be approved; assigning again replaces them.
+	if template.MultiAudit() {
+		if err := checkNotPendingAssignment(it.Contract(), it.TemplateID(), it.ID(), getStateFunc); err != nil {
+			return err
+		}
+	}
+
+	if err := checkQuota(
+		it.Contract(), it.TemplateID(), it.Holder(), template.Quota(),
+		ipp.holderStats, ipp.templateStats, getStateFunc,
+	); err != nil {
+		return err
+	}
+
+	// NOTE count the credential, so that the quota is checked with the
+	// previous items of the operation like Process does.
+	if !template.MultiAudit() {
+		if err := countTemplateCredential(
+			it.Contract(), it.TemplateID(), it.Holder(), true, ipp.holderStats, ipp.templateStats, getStateFunc,
+		); err != nil {
+			return err
 		}
 	}
 
@@ -124,58 +193,62 @@ func (ipp *AssignItemProcessor) Process(
 ) ([]base.StateMergeValue, error) {
 	it := ipp.item
 
-	*ipp.credentialCount++
-
-	sts := make([]base.StateMergeValue, 2)
-
-	credential := types.NewCredential(it.Holder(), it.TemplateID(), it.ID(), it.Value(), it.ValidFrom(), it.ValidUntil(), it.DID())
+	credential := it.Credential()
 	if err := credential.IsValid(nil); err != nil {
 		return nil, err
 	}
 
-	sts[0] = currencystate.NewStateMergeValue(
-		state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()),
-		state.NewCredentialStateValue(credential, true),
-	)
+	st, _, err := existsTemplateState(it.Contract(), it.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
 
-	sts[1] = currencystate.NewStateMergeValue(
-		state.StateKeyHolderDID(it.Contract(), it.Holder()),
-		state.NewHolderDIDStateValue(it.DID()),
-	)
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return nil, err
+	}
 
-	if len(*ipp.holders) == 0 {
-		*ipp.holders = append(*ipp.holders, types.NewHolder(it.Holder(), 1))
-	} else {
-		for i, h := range *ipp.holders {
-			if h.Address().Equal(it.Holder()) {
-				(*ipp.holders)[i] = types.NewHolder(h.Address(), h.CredentialCount()+1)
-				break
-			}
+	if template.MultiAudit() {
+		pa := state.NewPendingAssignmentStateValue(credential, ipp.sender, template.AuditorSet().Threshold(), nil)
+		pa.IssuerSign = it.IssuerSign()
 
-			if i == len(*ipp.holders)-1 {
-				*ipp.holders = append(*ipp.holders, types.NewHolder(it.Holder(), 1))
-			}
-		}
+		return []base.StateMergeValue{
+			currencystate.NewStateMergeValue(
+				state.StateKeyPendingAssignment(it.Contract(), it.TemplateID(), it.ID()),
+				pa,
+			),
+		}, nil
 	}
 
-	return sts, nil
+	return assignCredential(
+		it.Contract(), credential, it.IssuerSign(), template.Quota(),
+		ipp.credentialCount, ipp.holderCount, ipp.holderStats, ipp.templateStats, ipp.statusLists,
+		getStateFunc,
+	)
 }
 
 func (ipp *AssignItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = AssignItem{}
+	ipp.proposedAt = time.Time{}
+	ipp.networkID = nil
 	ipp.credentialCount = nil
-	ipp.holders = nil
+	ipp.holderCount = nil
+	ipp.holderStats = nil
+	ipp.templateStats = nil
+	ipp.statusLists = nil
 
 	assignItemProcessorPool.Put(ipp)
 }
 
 type AssignProcessor struct {
 	*base.BaseOperationProcessor
+	proposedAt ProposedAtFunc
+	networkID  base.NetworkID
 }
 
-func NewAssignProcessor() currencytypes.GetNewProcessor {
+func NewAssignProcessor(proposedAt ProposedAtFunc, networkID base.NetworkID) currencytypes.GetNewProcessor {
 	return func(
 		height base.Height,
 		getStateFunc base.GetStateFunc,
@@ -197,6 +270,8 @@ func NewAssignProcessor() currencytypes.GetNewProcessor {
 		}
 
 		opp.BaseOperationProcessor = b
+		opp.proposedAt = proposedAt
+		opp.networkID = networkID
 
 		return opp, nil
 	}
@@ -235,6 +310,14 @@ func (opp *AssignProcessor) PreProcess(
 		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
 	}
 
+	proposedAt, err := opp.proposedAt(opp.Height())
+	if err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	holderStats := map[string]*uint64{}
+	templateStats := map[string]*uint64{}
+
 	for _, it := range fact.Items() {
 		ip := assignItemProcessorPool.Get()
 		ipc, ok := ip.(*AssignItemProcessor)
@@ -245,8 +328,12 @@ func (opp *AssignProcessor) PreProcess(
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = it
+		ipc.proposedAt = proposedAt
+		ipc.networkID = opp.networkID
 		ipc.credentialCount = nil
-		ipc.holders = nil
+		ipc.holderCount = nil
+		ipc.holderStats = holderStats
+		ipc.templateStats = templateStats
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError(
@@ -270,7 +357,10 @@ func (opp *AssignProcessor) Process( // nolint:dupl
 	fact, _ := op.Fact().(AssignFact)
 	designs := map[string]types.Design{}
 	counters := map[string]*uint64{}
-	holders := map[string]*[]types.Holder{}
+	holderCounters := map[string]*uint64{}
+	holderStats := map[string]*uint64{}
+	templateStats := map[string]*uint64{}
+	statusLists := newStatusListChunks()
 
 	for _, it := range fact.Items() {
 		k := state.StateKeyDesign(it.Contract())
@@ -283,11 +373,11 @@ func (opp *AssignProcessor) Process( // nolint:dupl
 
 		design, _ := state.StateDesignValue(st)
 		count := design.Policy().CredentialCount()
-		holder := design.Policy().Holders()
+		holderCount := design.Policy().HolderCount()
 
 		designs[k] = design
 		counters[k] = &count
-		holders[k] = &holder
+		holderCounters[k] = &holderCount
 	}
 
 	var sts []base.StateMergeValue // nolint:prealloc
@@ -302,7 +392,10 @@ func (opp *AssignProcessor) Process( // nolint:dupl
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.credentialCount = counters[k]
-		ipc.holders = holders[k]
+		ipc.holderCount = holderCounters[k]
+		ipc.holderStats = holderStats
+		ipc.templateStats = templateStats
+		ipc.statusLists = statusLists
 
 		st, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
@@ -315,8 +408,8 @@ func (opp *AssignProcessor) Process( // nolint:dupl
 	}
 
 	for k, de := range designs {
-		policy := types.NewPolicy(de.Policy().TemplateIDs(), *holders[k], *counters[k])
-		design := types.NewDesign(policy)
+		policy := types.NewPolicy(de.Policy().TemplateCount(), *counters[k], *holderCounters[k], de.Policy().LegacyHolders())
+		design := types.NewDesign(policy, de.DIDMethods())
 		if err := design.IsValid(nil); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("invalid design, %s; %w", k, err), nil
 		}
@@ -329,6 +422,26 @@ func (opp *AssignProcessor) Process( // nolint:dupl
 		)
 	}
 
+	for k, count := range holderStats {
+		sts = append(sts,
+			currencystate.NewStateMergeValue(
+				k,
+				state.NewHolderStatStateValue(*count),
+			),
+		)
+	}
+
+	for k, count := range templateStats {
+		sts = append(sts,
+			currencystate.NewStateMergeValue(
+				k,
+				state.NewTemplateStatStateValue(*count),
+			),
+		)
+	}
+
+	sts = append(sts, statusLists.states()...)
+
 	items := make([]CredentialItem, len(fact.Items()))
 	for i := range fact.Items() {
 		items[i] = fact.Items()[i]
@@ -390,3 +503,622 @@ func calculateCredentialItemsFee(getStateFunc base.GetStateFunc, items []Credent
 	return required, nil
 
 }
+
+// assignCredential returns the states of the credential newly assigned to the
+// holder and counts the credential and the holder.
+func assignCredential(
+	contract base.Address,
+	credential types.Credential,
+	issuerSign *types.IssuerSign,
+	quota *types.Quota,
+	credentialCount, holderCount *uint64,
+	holderStats, templateStats map[string]*uint64,
+	statusLists *statusListChunks,
+	getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	if err := checkQuota(
+		contract, credential.TemplateID(), credential.Holder(), quota, holderStats, templateStats, getStateFunc,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := countTemplateCredential(
+		contract, credential.TemplateID(), credential.Holder(), true, holderStats, templateStats, getStateFunc,
+	); err != nil {
+		return nil, err
+	}
+
+	*credentialCount++
+
+	k := state.StateKeyCredential(contract, credential.TemplateID(), credential.ID())
+
+	index, err := statusLists.allocate(contract, credential.TemplateID(), getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	cv := state.NewCredentialStateValue(credential, types.CredentialStatusActive, 0)
+	cv.StatusIndex = &index
+	cv.IssuerSign = issuerSign
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(k, cv),
+		currencystate.NewStateMergeValue(
+			state.StateKeyHolderDID(contract, credential.Holder()),
+			state.NewHolderDIDStateValue(credential.DID()),
+		),
+		currencystate.NewStateMergeValue(
+			state.StateKeyDIDHolder(contract, credential.DID()),
+			state.NewDIDHolderStateValue(credential.Holder()),
+		),
+	}
+
+	count, err := serviceHolderStat(contract, credential.Holder(), holderStats, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	if *count == 0 {
+		*holderCount++
+	}
+	*count++
+
+	return sts, nil
+}
+
+// checkNotPendingAssignment checks the credential is not waiting for the
+// approvals of auditors.
+func checkNotPendingAssignment(contract base.Address, templateID, id string, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(state.StateKeyPendingAssignment(contract, templateID, id)); {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get pending assignment state")
+	case !found:
+		return nil
+	default:
+		pa, err := state.StatePendingAssignmentValue(st)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get pending assignment state")
+		}
+
+		if !pa.IsApproved() {
+			return errors.Errorf(
+				"credential assignment pending approval, %s-%s-%s; %d/%d",
+				contract, templateID, id, len(pa.Approvals), pa.Threshold,
+			)
+		}
+
+		return nil
+	}
+}
+
+// checkCredentialIDNotUsed checks the credential id is not used in the
+// template; the ids of the revoked and renounced credentials are not assigned
+// again, so the revocation is terminal.
+func checkCredentialIDNotUsed(contract base.Address, templateID, id string, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(state.StateKeyCredential(contract, templateID, id)); {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get credential state")
+	case !found:
+		return nil
+	default:
+		credential, _, err := state.StateCredentialValue(st)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get credential state")
+		}
+
+		status, err := state.StateCredentialStatusValue(st)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get credential state")
+		}
+
+		return errors.Errorf("credential id already used by holder account, %q; %s", credential.Holder(), status)
+	}
+}
+
+// checkHolderSigns verifies the holder signs of the item for the version of
+// credential and checks them against the keys of the holder account.
+func checkHolderSigns(it AssignItem, version uint64, networkID base.NetworkID, getStateFunc base.GetStateFunc) error {
+	st, err := currencystate.ExistsState(statecurrency.StateKeyAccount(it.Holder()), "key of holder account", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	keys, err := statecurrency.StateKeysValue(st)
+	switch {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get holder account keys")
+	case keys == nil:
+		return errors.Errorf("empty keys of holder account")
+	}
+
+	b := it.ConsentBytes(version)
+
+	signs := make([]base.Sign, len(it.HolderSigns()))
+	for i, s := range it.HolderSigns() {
+		if err := s.Verify(networkID, b); err != nil {
+			return err
+		}
+
+		signs[i] = s
+	}
+
+	return currencytypes.CheckThreshold(signs, keys)
+}
+
+// checkIssuerSign verifies the issuer sign over the credential and checks the
+// signer is a key of the owner or the operators of the contract account.
+func checkIssuerSign(
+	ca currencytypes.ContractAccountStatus, contract base.Address, credential types.Credential,
+	sign types.IssuerSign, networkID base.NetworkID, getStateFunc base.GetStateFunc,
+) error {
+	if err := sign.Verify(networkID, contract, credential); err != nil {
+		return err
+	}
+
+	issuers := append([]base.Address{ca.Owner()}, ca.Operators()...)
+
+	for i := range issuers {
+		st, found, err := getStateFunc(statecurrency.StateKeyAccount(issuers[i]))
+		switch {
+		case err != nil:
+			return errors.Wrapf(err, "failed to get account state, %q", issuers[i])
+		case !found:
+			continue
+		}
+
+		keys, err := statecurrency.StateKeysValue(st)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get account keys, %q", issuers[i])
+		}
+
+		if keys == nil {
+			continue
+		}
+
+		if _, found := keys.Key(sign.Signer()); found {
+			return nil
+		}
+	}
+
+	return errors.Errorf("signer not key of owner or operators of contract account, %q", sign.Signer())
+}
+
+// checkHolderDID checks that the did can be bound to the holder in the
+// service; the holder keeps the did of its first assignment until it is
+// changed by UpdateHolderDID, and the did can not be bound to the other holder.
+func checkHolderDID(contract, holder base.Address, did string, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(state.StateKeyHolderDID(contract, holder)); {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get holder did state")
+	case found:
+		switch d, err := state.StateHolderDIDValue(st); {
+		case err != nil:
+			return errors.Wrapf(err, "failed to get holder did value")
+		case d != did:
+			return errors.Errorf("holder already has the other did, %q; %q", holder, d)
+		}
+	}
+
+	return checkDIDHolder(contract, holder, did, getStateFunc)
+}
+
+// checkDIDHolder checks that the did is not bound to the other holder in the
+// service.
+func checkDIDHolder(contract, holder base.Address, did string, getStateFunc base.GetStateFunc) error {
+	switch st, found, err := getStateFunc(state.StateKeyDIDHolder(contract, did)); {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get did holder state")
+	case !found:
+		return nil
+	default:
+		h, err := state.StateDIDHolderValue(st)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get did holder value")
+		}
+
+		if h.Equal(holder) {
+			return nil
+		}
+
+		switch st, found, err := getStateFunc(state.StateKeyHolderDID(contract, h)); {
+		case err != nil:
+			return errors.Wrapf(err, "failed to get holder did state")
+		case !found:
+			return nil
+		default:
+			if d, err := state.StateHolderDIDValue(st); err != nil {
+				return errors.Wrapf(err, "failed to get holder did value")
+			} else if d == did {
+				return errors.Errorf("did already bound to the other holder, %q; %q", did, h)
+			}
+		}
+	}
+
+	return nil
+}
+
+// holderStat returns the credential count of the holder stat state key. The
+// count is loaded from state once per operation and shared through stats so
+// that items of the same holder accumulate.
+func holderStat(k string, stats map[string]*uint64, getStateFunc base.GetStateFunc) (*uint64, error) {
+	if count, found := stats[k]; found {
+		return count, nil
+	}
+
+	var count uint64
+
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to get holder stat state, %s", k)
+	case found:
+		c, err := state.StateHolderStatValue(st)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get holder stat value, %s", k)
+		}
+		count = c
+	}
+
+	stats[k] = &count
+
+	return &count, nil
+}
+
+// templateStat returns the credential count of the template stat state key;
+// it is shared through stats like holderStat.
+func templateStat(k string, stats map[string]*uint64, getStateFunc base.GetStateFunc) (*uint64, error) {
+	if count, found := stats[k]; found {
+		return count, nil
+	}
+
+	var count uint64
+
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to get template stat state, %s", k)
+	case found:
+		c, err := state.StateTemplateStatValue(st)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get template stat value, %s", k)
+		}
+		count = c
+	}
+
+	stats[k] = &count
+
+	return &count, nil
+}
+
+// checkCredentialValue checks the value of credential against template. The
+// value of the private value or selective disclosure template is the
+// commitment, so the schema is checked only when the value or claims are
+// presented off chain.
+func checkCredentialValue(template types.Template, value string) error {
+	switch {
+	case bool(template.PrivateValue()):
+		if !types.IsValueCommitment(value) {
+			return errors.Errorf("value not commitment of private value template; %q expected", types.ValueCommitmentPrefix)
+		}
+
+		return nil
+	case bool(template.SelectiveDisclosure()):
+		_, err := types.ParseSDValue(value)
+
+		return err
+	}
+
+	if schema := template.Schema(); schema != nil {
+		return schema.Validate(value)
+	}
+
+	return nil
+}
+
+// checkQuota checks one more credential of the template for the holder is
+// inside of the quota of the template.
+func checkQuota(
+	contract base.Address, templateID string, holder base.Address, quota *types.Quota,
+	holderStats, templateStats map[string]*uint64, getStateFunc base.GetStateFunc,
+) error {
+	if quota == nil {
+		return nil
+	}
+
+	owner, err := templateOwner(contract, templateID, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if quota.MaxSupply() > 0 {
+		supply, err := templateStat(state.StateKeyTemplateStat(owner, templateID), templateStats, getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		if *supply >= quota.MaxSupply() {
+			return errors.Errorf("max supply of template reached, %q; %d", templateID, quota.MaxSupply())
+		}
+	}
+
+	if quota.MaxPerHolder() > 0 {
+		held, err := holderStat(state.StateKeyTemplateHolderStat(owner, templateID, holder), holderStats, getStateFunc)
+		if err != nil {
+			return err
+		}
+
+		if *held >= quota.MaxPerHolder() {
+			return errors.Errorf("max credentials of template per holder reached, %q, %q; %d", templateID, holder, quota.MaxPerHolder())
+		}
+	}
+
+	return nil
+}
+
+// countTemplateCredential counts up or down the credentials of the holder in
+// the template and counts up the issued credentials of the template; the
+// issued count is not counted down. The credentials assigned before the
+// counts existed are not counted, so the counts do not go below zero. The
+// counts of granted template are kept by the owner service of the template.
+func countTemplateCredential(
+	contract base.Address, templateID string, holder base.Address, up bool,
+	holderStats, templateStats map[string]*uint64, getStateFunc base.GetStateFunc,
+) error {
+	owner, err := templateOwner(contract, templateID, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	held, err := holderStat(state.StateKeyTemplateHolderStat(owner, templateID, holder), holderStats, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	if !up {
+		if *held > 0 {
+			*held--
+		}
+
+		return nil
+	}
+
+	supply, err := templateStat(state.StateKeyTemplateStat(owner, templateID), templateStats, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	*supply++
+	*held++
+
+	return nil
+}
+
+// statusListChunks keeps the chunks and the sizes of the status lists changed
+// by an operation. Like holderStat, each state is loaded once per operation
+// and only the changed chunks are written. The status list of granted template
+// is kept by the owner service of the template.
+type statusListChunks struct {
+	chunks map[string]*types.StatusList
+	sizes  map[string]*uint64
+}
+
+func newStatusListChunks() *statusListChunks {
+	return &statusListChunks{
+		chunks: map[string]*types.StatusList{},
+		sizes:  map[string]*uint64{},
+	}
+}
+
+// allocate appends a bit to the status list of the template and returns the
+// index of the bit.
+func (sc *statusListChunks) allocate(
+	contract base.Address, templateID string, getStateFunc base.GetStateFunc,
+) (uint64, error) {
+	size, err := sc.size(contract, templateID, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	index := *size
+
+	sl, err := sc.chunk(contract, templateID, index/types.StatusListChunkBits, getStateFunc)
+	if err != nil {
+		return 0, err
+	}
+
+	var i uint64
+	if *sl, i = sl.Append(); i != index%types.StatusListChunkBits {
+		return 0, errors.Errorf(
+			"status list chunk not matched with size, %s, %s; %d != %d", contract, templateID, i, index)
+	}
+
+	*size++
+
+	return index, nil
+}
+
+// set sets the bit of index in the status list of the template.
+func (sc *statusListChunks) set(
+	contract base.Address, templateID string, index uint64, getStateFunc base.GetStateFunc,
+) error {
+	sl, err := sc.chunk(contract, templateID, index/types.StatusListChunkBits, getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	*sl, err = sl.Set(index % types.StatusListChunkBits)
+
+	return err
+}
+
+func (sc *statusListChunks) states() []base.StateMergeValue {
+	sts := make([]base.StateMergeValue, 0, len(sc.chunks)+len(sc.sizes))
+
+	for k, sl := range sc.chunks {
+		sts = append(sts, currencystate.NewStateMergeValue(k, state.NewStatusListStateValue(*sl)))
+	}
+
+	for k, size := range sc.sizes {
+		sts = append(sts, currencystate.NewStateMergeValue(k, state.NewStatusListSizeStateValue(*size)))
+	}
+
+	return sts
+}
+
+func (sc *statusListChunks) size(
+	contract base.Address, templateID string, getStateFunc base.GetStateFunc,
+) (*uint64, error) {
+	owner, err := templateOwner(contract, templateID, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	k := state.StateKeyStatusListSize(owner, templateID)
+	if size, found := sc.sizes[k]; found {
+		return size, nil
+	}
+
+	var size uint64
+
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to get status list size state, %s", k)
+	case found:
+		v, err := state.StateStatusListSizeValue(st)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get status list size value, %s", k)
+		}
+		size = v
+	}
+
+	sc.sizes[k] = &size
+
+	return &size, nil
+}
+
+func (sc *statusListChunks) chunk(
+	contract base.Address, templateID string, chunk uint64, getStateFunc base.GetStateFunc,
+) (*types.StatusList, error) {
+	owner, err := templateOwner(contract, templateID, getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	k := state.StateKeyStatusList(owner, templateID, chunk)
+	if sl, found := sc.chunks[k]; found {
+		return sl, nil
+	}
+
+	sl := types.NewStatusList(0, nil)
+
+	switch st, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to get status list state, %s", k)
+	case found:
+		v, err := state.StateStatusListValue(st)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get status list value, %s", k)
+		}
+		sl = v
+	}
+
+	sc.chunks[k] = &sl
+
+	return &sl, nil
+}
+
+// serviceHolderStat returns the credential count of holder in the credential
+// service like holderStat. The holder without holder stat state is migrated
+// from the legacy holders list of design, so that only the touched holders
+// are written.
+func serviceHolderStat(
+	contract, holder base.Address, stats map[string]*uint64, getStateFunc base.GetStateFunc,
+) (*uint64, error) {
+	k := state.StateKeyHolderStat(contract, holder)
+	if count, found := stats[k]; found {
+		return count, nil
+	}
+
+	switch _, found, err := getStateFunc(k); {
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to get holder stat state, %s", k)
+	case found:
+		return holderStat(k, stats, getStateFunc)
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyDesign(contract), "key of design", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	var count uint64
+
+	for _, h := range design.Policy().LegacyHolders() {
+		if h.Address().Equal(holder) {
+			count = h.CredentialCount()
+
+			break
+		}
+	}
+
+	stats[k] = &count
+
+	return &count, nil
+}
+
+// checkTemplateInService checks the block is proposed in the service period
+// of template.
+func checkTemplateInService(template types.Template, proposedAt time.Time) error {
+	from, until, err := template.ServicePeriod()
+	if err != nil {
+		return errors.Wrapf(err, "invalid service period of template, %q", template.TemplateID())
+	}
+
+	switch {
+	case proposedAt.Before(from):
+		return errors.Errorf(
+			"template not yet in service, %q; service date %s, proposed at %s",
+			template.TemplateID(), template.ServiceDate(), proposedAt.UTC().Format(time.RFC3339),
+		)
+	case !proposedAt.Before(until):
+		return errors.Errorf(
+			"template expired, %q; expiration date %s, proposed at %s",
+			template.TemplateID(), template.ExpirationDate(), proposedAt.UTC().Format(time.RFC3339),
+		)
+	}
+
+	return nil
+}
+
+// checkTemplateServicePeriod checks the template is in service at proposedAt
+// and the validity of credential, in unix seconds, is inside of the service
+// period of template.
+func checkTemplateServicePeriod(template types.Template, proposedAt time.Time, validFrom, validUntil uint64) error {
+	if err := checkTemplateInService(template, proposedAt); err != nil {
+		return err
+	}
+
+	from, until, err := template.ServicePeriod()
+	if err != nil {
+		return errors.Wrapf(err, "invalid service period of template, %q", template.TemplateID())
+	}
+
+	if from.Unix() > 0 && validFrom < uint64(from.Unix()) {
+		return errors.Errorf(
+			"valid from is before service date of template, %q; %d < %d",
+			template.TemplateID(), validFrom, from.Unix(),
+		)
+	}
+
+	if until.Unix() < 0 || validUntil > uint64(until.Unix()) {
+		return errors.Errorf(
+			"valid until is after expiration date of template, %q; %d > %d",
+			template.TemplateID(), validUntil, until.Unix(),
+		)
+	}
+
+	return nil
+}
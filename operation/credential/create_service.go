@@ -1,6 +1,7 @@
 package credential
 
 import (
+	"github.com/ProtoconNet/mitum-credential/types"
 	"github.com/ProtoconNet/mitum-currency/v3/common"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
@@ -16,23 +17,26 @@ var (
 
 type CreateServiceFact struct {
 	base.BaseFact
-	sender   base.Address
-	contract base.Address
-	currency currencytypes.CurrencyID
+	sender     base.Address
+	contract   base.Address
+	didMethods []string
+	currency   currencytypes.CurrencyID
 }
 
 func NewCreateServiceFact(
 	token []byte,
 	sender base.Address,
 	contract base.Address,
+	didMethods []string,
 	currency currencytypes.CurrencyID,
 ) CreateServiceFact {
 	bf := base.NewBaseFact(CreateServiceFactHint, token)
 	fact := CreateServiceFact{
-		BaseFact: bf,
-		sender:   sender,
-		contract: contract,
-		currency: currency,
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		didMethods: didMethods,
+		currency:   currency,
 	}
 	fact.SetHash(fact.GenerateHash())
 
@@ -52,6 +56,7 @@ func (fact CreateServiceFact) Bytes() []byte {
 		fact.Token(),
 		fact.sender.Bytes(),
 		fact.contract.Bytes(),
+		types.DIDMethodsBytes(fact.didMethods),
 		fact.currency.Bytes(),
 	)
 }
@@ -71,6 +76,10 @@ func (fact CreateServiceFact) IsValid(b []byte) error {
 		return err
 	}
 
+	if err := types.IsValidDIDMethods(fact.didMethods); err != nil {
+		return err
+	}
+
 	if fact.sender.Equal(fact.contract) {
 		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
 	}
@@ -94,6 +103,10 @@ func (fact CreateServiceFact) Contract() base.Address {
 	return fact.contract
 }
 
+func (fact CreateServiceFact) DIDMethods() []string {
+	return fact.didMethods
+}
+
 func (fact CreateServiceFact) Currency() currencytypes.CurrencyID {
 	return fact.currency
 }
@@ -11,23 +11,28 @@ import (
 )
 
 func (fact CreateServiceFact) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":    fact.Hint().String(),
-			"sender":   fact.sender,
-			"contract": fact.contract,
-			"currency": fact.currency,
-			"hash":     fact.BaseFact.Hash().String(),
-			"token":    fact.BaseFact.Token(),
-		},
-	)
+	m := bson.M{
+		"_hint":    fact.Hint().String(),
+		"sender":   fact.sender,
+		"contract": fact.contract,
+		"currency": fact.currency,
+		"hash":     fact.BaseFact.Hash().String(),
+		"token":    fact.BaseFact.Token(),
+	}
+
+	if len(fact.didMethods) > 0 {
+		m["did_methods"] = fact.didMethods
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CreateServiceFactBSONUnmarshaler struct {
-	Hint     string `bson:"_hint"`
-	Sender   string `bson:"sender"`
-	Contract string `bson:"contract"`
-	Currency string `bson:"currency"`
+	Hint       string   `bson:"_hint"`
+	Sender     string   `bson:"sender"`
+	Contract   string   `bson:"contract"`
+	DIDMethods []string `bson:"did_methods"`
+	Currency   string   `bson:"currency"`
 }
 
 func (fact *CreateServiceFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -53,7 +58,7 @@ func (fact *CreateServiceFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 	}
 	fact.BaseHinter = hint.NewBaseHinter(ht)
 
-	return fact.unpack(enc, uf.Sender, uf.Contract, uf.Currency)
+	return fact.unpack(enc, uf.Sender, uf.Contract, uf.DIDMethods, uf.Currency)
 }
 
 func (op CreateService) MarshalBSON() ([]byte, error) {
@@ -7,7 +7,7 @@ import (
 	"github.com/ProtoconNet/mitum2/util/encoder"
 )
 
-func (fact *CreateServiceFact) unpack(enc encoder.Encoder, sAdr, cAdr, cid string) error {
+func (fact *CreateServiceFact) unpack(enc encoder.Encoder, sAdr, cAdr string, didMethods []string, cid string) error {
 	e := util.StringError("failed to unmarshal CreateServiceFact")
 
 	switch a, err := base.DecodeAddress(sAdr, enc); {
@@ -24,6 +24,7 @@ func (fact *CreateServiceFact) unpack(enc encoder.Encoder, sAdr, cAdr, cid strin
 		fact.contract = a
 	}
 
+	fact.didMethods = didMethods
 	fact.currency = currencytypes.CurrencyID(cid)
 
 	return nil
@@ -10,9 +10,10 @@ import (
 
 type CreateServiceFactJSONMarshaler struct {
 	base.BaseFactJSONMarshaler
-	Owner    base.Address             `json:"sender"`
-	Contract base.Address             `json:"contract"`
-	Currency currencytypes.CurrencyID `json:"currency"`
+	Owner      base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	DIDMethods []string                 `json:"did_methods,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
 }
 
 func (fact CreateServiceFact) MarshalJSON() ([]byte, error) {
@@ -20,15 +21,17 @@ func (fact CreateServiceFact) MarshalJSON() ([]byte, error) {
 		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
 		Owner:                 fact.sender,
 		Contract:              fact.contract,
+		DIDMethods:            fact.didMethods,
 		Currency:              fact.currency,
 	})
 }
 
 type CreateServiceFactJSONUnMarshaler struct {
 	base.BaseFactJSONUnmarshaler
-	Owner    string `json:"sender"`
-	Contract string `json:"contract"`
-	Currency string `json:"currency"`
+	Owner      string   `json:"sender"`
+	Contract   string   `json:"contract"`
+	DIDMethods []string `json:"did_methods"`
+	Currency   string   `json:"currency"`
 }
 
 func (fact *CreateServiceFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -41,7 +44,7 @@ func (fact *CreateServiceFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error
 
 	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
 
-	return fact.unpack(enc, uf.Owner, uf.Contract, uf.Currency)
+	return fact.unpack(enc, uf.Owner, uf.Contract, uf.DIDMethods, uf.Currency)
 }
 
 type CreateServiceMarshaler struct {
@@ -133,15 +133,12 @@ func (opp *CreateServiceProcessor) Process(
 		return nil, nil, e.Errorf("expected CreateServiceFact, not %T", op.Fact())
 	}
 
-	var templates []string
-	var holders []crendentialtypes.Holder
-
-	policy := crendentialtypes.NewPolicy(templates, holders, 0)
+	policy := crendentialtypes.NewPolicy(0, 0, 0, nil)
 	if err := policy.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid credential policy, %s; %w", fact.Contract(), err), nil
 	}
 
-	design := crendentialtypes.NewDesign(policy)
+	design := crendentialtypes.NewDesign(policy, fact.DIDMethods())
 	if err := design.IsValid(nil); err != nil {
 		return nil, base.NewBaseOperationProcessReasonError("invalid credential design, %s; %w", fact.Contract(), err), nil
 	}
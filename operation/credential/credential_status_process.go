@@ -0,0 +1,342 @@
+package credential
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var credentialStatusItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CredentialStatusItemProcessor)
+	},
+}
+
+var credentialStatusProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(CredentialStatusProcessor)
+	},
+}
+
+// CredentialStatusItem is the item which changes the status of the credential
+// of holder.
+type CredentialStatusItem interface {
+	CredentialItem
+	Contract() base.Address
+	Holder() base.Address
+	TemplateID() string
+	ID() string
+}
+
+// CredentialStatusFact is the fact of the items which change the status of
+// credentials.
+type CredentialStatusFact interface {
+	base.Fact
+	Sender() base.Address
+	StatusItems() []CredentialStatusItem
+}
+
+// CredentialStatusItemProcessor changes the status of credential from the
+// status, from, to the status, to.
+type CredentialStatusItemProcessor struct {
+	h          util.Hash
+	sender     base.Address
+	item       CredentialStatusItem
+	from       types.CredentialStatus
+	to         types.CredentialStatus
+	proposedAt time.Time
+}
+
+func (ipp *CredentialStatusItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.StateKeyAccount(it.Holder()), getStateFunc); err != nil {
+		return err
+	}
+
+	if err := currencystate.CheckNotExistsState(extension.StateKeyContractAccount(it.Holder()), getStateFunc); err != nil {
+		return err
+	}
+
+	st, err := currencystate.ExistsState(extension.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	ca, err := extension.StateContractAccountValue(st)
+	if err != nil {
+		return err
+	}
+
+	if err := checkServiceRole(ca, it.Contract(), ipp.sender, types.RoleRevoker, it.TemplateID(), getStateFunc); err != nil {
+		return err
+	}
+
+	if st, err := currencystate.ExistsState(state.StateKeyDesign(it.Contract()), "key of design", getStateFunc); err != nil {
+		return errors.Wrapf(err, "failed to get design state of credential service")
+	} else if de, err := state.StateDesignValue(st); err != nil {
+		return errors.Wrapf(err, "failed to get design value of credential service from state")
+	} else {
+		if err := de.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if _, _, err := existsTemplateState(it.Contract(), it.TemplateID(), getStateFunc); err != nil {
+		return errors.Wrapf(err, "templateID not found, %q", it.TemplateID())
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()), "key of credential", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	credential, _, err := state.StateCredentialValue(st)
+	if err != nil {
+		return err
+	}
+
+	if !credential.Holder().Equal(it.Holder()) {
+		return errors.Errorf("credential not assigned to holder, %s-%s, %s", it.Contract(), it.ID(), it.Holder())
+	}
+
+	switch status, err := state.StateCredentialStatusValue(st); {
+	case err != nil:
+		return err
+	case status.At(credential.ValidUntil(), ipp.proposedAt) != ipp.from:
+		return errors.Errorf(
+			"not %s credential, %s-%s, %s; %s",
+			ipp.from, it.Contract(), it.ID(), credential.Holder(), status.At(credential.ValidUntil(), ipp.proposedAt),
+		)
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ipp *CredentialStatusItemProcessor) Process(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	st, err := currencystate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()), "key of credential", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	cv.Status = ipp.to
+
+	return []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()),
+			cv,
+		),
+	}, nil
+}
+
+func (ipp *CredentialStatusItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = nil
+	ipp.from = ""
+	ipp.to = ""
+	ipp.proposedAt = time.Time{}
+
+	credentialStatusItemProcessorPool.Put(ipp)
+}
+
+// CredentialStatusProcessor processes the operation which changes the status
+// of credentials, like Suspend and Reinstate.
+type CredentialStatusProcessor struct {
+	*base.BaseOperationProcessor
+	name       string
+	from       types.CredentialStatus
+	to         types.CredentialStatus
+	proposedAt ProposedAtFunc
+}
+
+func newCredentialStatusProcessor(
+	name string, from, to types.CredentialStatus, proposedAt ProposedAtFunc,
+) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new %sProcessor", name)
+
+		nopp := credentialStatusProcessorPool.Get()
+		opp, ok := nopp.(*CredentialStatusProcessor)
+		if !ok {
+			return nil, e.Errorf("expected CredentialStatusProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.name = name
+		opp.from = from
+		opp.to = to
+		opp.proposedAt = proposedAt
+
+		return opp, nil
+	}
+}
+
+func (opp *CredentialStatusProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess %s", opp.name)
+
+	fact, ok := op.Fact().(CredentialStatusFact)
+	if !ok {
+		return ctx, nil, e.Errorf("expected CredentialStatusFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extension.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError(
+			"contract account cannot change status of credential, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	proposedAt, err := opp.proposedAt(opp.Height())
+	if err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	for _, it := range fact.StatusItems() {
+		ip := credentialStatusItemProcessorPool.Get()
+		ipc, ok := ip.(*CredentialStatusItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected CredentialStatusItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.from = opp.from
+		ipc.to = opp.to
+		ipc.proposedAt = proposedAt
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess %sItem; %w", opp.name, err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *CredentialStatusProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process %s", opp.name)
+
+	fact, ok := op.Fact().(CredentialStatusFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected CredentialStatusFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.StatusItems() {
+		ip := credentialStatusItemProcessorPool.Get()
+		ipc, ok := ip.(*CredentialStatusItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected CredentialStatusItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.from = opp.from
+		ipc.to = opp.to
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process %sItem; %w", opp.name, err), nil
+		}
+
+		sts = append(sts, st...)
+		ipc.Close()
+	}
+
+	items := make([]CredentialItem, len(fact.StatusItems()))
+	for i, it := range fact.StatusItems() {
+		items[i] = it
+	}
+
+	required, err := calculateCredentialItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.Sender(), required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := statecurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *CredentialStatusProcessor) Close() error {
+	opp.name = ""
+	opp.from = ""
+	opp.to = ""
+	opp.proposedAt = nil
+
+	credentialStatusProcessorPool.Put(opp)
+
+	return nil
+}
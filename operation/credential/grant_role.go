@@ -0,0 +1,149 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	GrantRoleFactHint = hint.MustNewHint("mitum-credential-grant-role-operation-fact-v0.0.1")
+	GrantRoleHint     = hint.MustNewHint("mitum-credential-grant-role-operation-v0.0.1")
+)
+
+// GrantRoleFact gives the role to the account in the credential service; the
+// role covers every template of the service when the template id is empty.
+type GrantRoleFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	account    base.Address
+	role       types.Role
+	currency   currencytypes.CurrencyID
+}
+
+func NewGrantRoleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	account base.Address,
+	role types.Role,
+	currency currencytypes.CurrencyID,
+) GrantRoleFact {
+	bf := base.NewBaseFact(GrantRoleFactHint, token)
+	fact := GrantRoleFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		account:    account,
+		role:       role,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GrantRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GrantRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantRoleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.account.Bytes(),
+		fact.role.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact GrantRoleFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.role,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("account address is same with contract, %q", fact.account)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact GrantRoleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GrantRoleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact GrantRoleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GrantRoleFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact GrantRoleFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact GrantRoleFact) Role() types.Role {
+	return fact.role
+}
+
+func (fact GrantRoleFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact GrantRoleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.account
+	return as, nil
+}
+
+type GrantRole struct {
+	common.BaseOperation
+}
+
+func NewGrantRole(fact GrantRoleFact) (GrantRole, error) {
+	return GrantRole{BaseOperation: common.NewBaseOperation(GrantRoleHint, fact)}, nil
+}
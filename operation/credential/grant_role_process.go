@@ -0,0 +1,230 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var grantRoleProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(GrantRoleProcessor)
+	},
+}
+
+func (GrantRole) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type GrantRoleProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewGrantRoleProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new GrantRoleProcessor")
+
+		nopp := grantRoleProcessorPool.Get()
+		opp, ok := nopp.(*GrantRoleProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected GrantRoleProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *GrantRoleProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess GrantRole")
+
+	fact, ok := op.Fact().(GrantRoleFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", GrantRoleFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found from state, %q; %w", fact.Contract(), err), nil
+	}
+
+	if !(ca.Owner().Equal(fact.sender) || ca.IsOperator(fact.Sender())) {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is neither the owner nor the operator of the target contract account, %q", fact.sender), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	if len(fact.TemplateID()) > 0 {
+		if _, _, err := existsTemplateState(fact.Contract(), fact.TemplateID(), getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("template not found, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+		}
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Account()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("account state not found, %q; %w", fact.Account(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Account()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account cannot have role, %q; %w", fact.Account(), err), nil
+	}
+
+	roles, err := stateRoles(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get roles, %q, %s; %w", fact.Account(), fact.Contract(), err), nil
+	}
+
+	rg := types.NewRoleGrant(fact.Role(), fact.TemplateID())
+
+	for i := range roles.Roles {
+		if roles.Roles[i].Equal(rg) {
+			return nil, base.NewBaseOperationProcessReasonError("account already has role, %q, %q, %q", fact.Account(), fact.Role(), fact.TemplateID()), nil
+		}
+	}
+
+	if len(roles.Roles) >= types.MaxRoleGrants {
+		return nil, base.NewBaseOperationProcessReasonError("roles of account over max, %q, %d", fact.Account(), types.MaxRoleGrants), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *GrantRoleProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(GrantRoleFact)
+
+	roles, err := stateRoles(fact.Contract(), fact.Account(), getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to get roles, %q, %s; %w", fact.Account(), fact.Contract(), err), nil
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = currencystate.NewStateMergeValue(
+		state.StateKeyRoles(fact.Contract(), fact.Account()),
+		state.NewRolesStateValue(append(roles.Roles, types.NewRoleGrant(fact.Role(), fact.TemplateID()))),
+	)
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts[1] = currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))))
+
+	return sts, nil, nil
+}
+
+func (opp *GrantRoleProcessor) Close() error {
+	grantRoleProcessorPool.Put(opp)
+
+	return nil
+}
+
+func stateRoles(contract, account base.Address, getStateFunc base.GetStateFunc) (state.RolesStateValue, error) {
+	switch st, found, err := getStateFunc(state.StateKeyRoles(contract, account)); {
+	case err != nil:
+		return state.RolesStateValue{}, err
+	case !found:
+		return state.NewRolesStateValue(nil), nil
+	default:
+		return state.StateRolesValue(st)
+	}
+}
+
+// checkServiceRole checks the sender is the owner or the operator of the
+// contract account or has the role for the template.
+func checkServiceRole(
+	ca currencytypes.ContractAccountStatus, contract, sender base.Address,
+	role types.Role, templateID string, getStateFunc base.GetStateFunc,
+) error {
+	if ca.Owner().Equal(sender) || ca.IsOperator(sender) {
+		return nil
+	}
+
+	switch roles, err := stateRoles(contract, sender, getStateFunc); {
+	case err != nil:
+		return err
+	case !roles.Has(role, templateID):
+		return errors.Errorf(
+			"sender is neither the owner nor the operator of the target contract account nor %s of template, %q, %q",
+			role, sender, templateID,
+		)
+	default:
+		return nil
+	}
+}
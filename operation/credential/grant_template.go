@@ -0,0 +1,191 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	GrantTemplateFactHint = hint.MustNewHint("mitum-credential-grant-template-operation-fact-v0.0.1")
+	GrantTemplateHint     = hint.MustNewHint("mitum-credential-grant-template-operation-v0.0.1")
+)
+
+var acceptGrantBytesPrefix = []byte("mitum-credential-accept-template-grant")
+
+// GrantTemplateFact lets the grantee credential service assign credentials
+// with the shared template of the contract. The quota and the status list of
+// the template are kept by the contract for all the grantees. A grant is
+// permanent, so the grantee accepts it by the signs of the owner of grantee
+// contract account over AcceptBytes; the contract stops the assignments of
+// grantees by turning off the template share of the template.
+type GrantTemplateFact struct {
+	base.BaseFact
+	sender       base.Address
+	contract     base.Address
+	templateID   string
+	grantee      base.Address
+	granteeSigns []base.BaseSign
+	currency     currencytypes.CurrencyID
+}
+
+func NewGrantTemplateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	grantee base.Address,
+	granteeSigns []base.BaseSign,
+	currency currencytypes.CurrencyID,
+) GrantTemplateFact {
+	bf := base.NewBaseFact(GrantTemplateFactHint, token)
+	fact := GrantTemplateFact{
+		BaseFact:     bf,
+		sender:       sender,
+		contract:     contract,
+		templateID:   templateID,
+		grantee:      grantee,
+		granteeSigns: granteeSigns,
+		currency:     currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact GrantTemplateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact GrantTemplateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact GrantTemplateFact) Bytes() []byte {
+	signs := make([][]byte, len(fact.granteeSigns))
+	for i := range fact.granteeSigns {
+		signs[i] = fact.granteeSigns[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.grantee.Bytes(),
+		util.ConcatBytesSlice(signs...),
+		fact.currency.Bytes(),
+	)
+}
+
+// AcceptBytes returns the bytes which the owner of grantee contract account
+// signs to accept the grant.
+func (fact GrantTemplateFact) AcceptBytes() []byte {
+	return util.ConcatBytesSlice(
+		acceptGrantBytesPrefix,
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.grantee.Bytes(),
+	)
+}
+
+func (fact GrantTemplateFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.grantee,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.grantee.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("grantee address is same with contract, %q", fact.grantee)
+	}
+
+	if fact.grantee.Equal(fact.sender) {
+		return util.ErrInvalid.Errorf("grantee address is same with sender, %q", fact.grantee)
+	}
+
+	if len(fact.granteeSigns) < 1 {
+		return util.ErrInvalid.Errorf("empty grantee signs")
+	}
+
+	signers := map[string]struct{}{}
+	for i := range fact.granteeSigns {
+		if err := fact.granteeSigns[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		k := fact.granteeSigns[i].Signer().String()
+		if _, found := signers[k]; found {
+			return util.ErrInvalid.Errorf("duplicate grantee signer, %q", k)
+		}
+
+		signers[k] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact GrantTemplateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact GrantTemplateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact GrantTemplateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact GrantTemplateFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact GrantTemplateFact) Grantee() base.Address {
+	return fact.grantee
+}
+
+func (fact GrantTemplateFact) GranteeSigns() []base.BaseSign {
+	return fact.granteeSigns
+}
+
+func (fact GrantTemplateFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact GrantTemplateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.grantee
+	return as, nil
+}
+
+type GrantTemplate struct {
+	common.BaseOperation
+}
+
+func NewGrantTemplate(fact GrantTemplateFact) (GrantTemplate, error) {
+	return GrantTemplate{BaseOperation: common.NewBaseOperation(GrantTemplateHint, fact)}, nil
+}
@@ -0,0 +1,97 @@
+package credential // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact GrantTemplateFact) MarshalBSON() ([]byte, error) {
+	signs := make([]holderSignUnpacker, len(fact.granteeSigns))
+	for i := range fact.granteeSigns {
+		signs[i] = newHolderSignUnpacker(fact.granteeSigns[i])
+	}
+
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":         fact.Hint().String(),
+			"sender":        fact.sender,
+			"contract":      fact.contract,
+			"template_id":   fact.templateID,
+			"grantee":       fact.grantee,
+			"grantee_signs": signs,
+			"currency":      fact.currency,
+			"hash":          fact.BaseFact.Hash().String(),
+			"token":         fact.BaseFact.Token(),
+		},
+	)
+}
+
+type GrantTemplateFactBSONUnmarshaler struct {
+	Hint         string               `bson:"_hint"`
+	Sender       string               `bson:"sender"`
+	Contract     string               `bson:"contract"`
+	TemplateID   string               `bson:"template_id"`
+	Grantee      string               `bson:"grantee"`
+	GranteeSigns []holderSignUnpacker `bson:"grantee_signs"`
+	Currency     string               `bson:"currency"`
+}
+
+func (fact *GrantTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GrantTemplateFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf GrantTemplateFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Grantee,
+		uf.GranteeSigns,
+		uf.Currency)
+}
+
+func (op GrantTemplate) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *GrantTemplate) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of GrantTemplate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
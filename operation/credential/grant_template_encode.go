@@ -0,0 +1,52 @@
+package credential
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *GrantTemplateFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID, gAdr string,
+	granteeSigns []holderSignUnpacker,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal GrantTemplateFact")
+
+	fact.templateID = tmplID
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(gAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.grantee = a
+	}
+
+	fact.granteeSigns = make([]base.BaseSign, len(granteeSigns))
+	for i := range granteeSigns {
+		sign, err := granteeSigns[i].sign(enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+
+		fact.granteeSigns[i] = sign
+	}
+
+	return nil
+}
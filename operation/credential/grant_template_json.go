@@ -0,0 +1,84 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type GrantTemplateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender       base.Address             `json:"sender"`
+	Contract     base.Address             `json:"contract"`
+	TemplateID   string                   `json:"template_id"`
+	Grantee      base.Address             `json:"grantee"`
+	GranteeSigns []base.BaseSign          `json:"grantee_signs"`
+	Currency     currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact GrantTemplateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GrantTemplateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Grantee:               fact.grantee,
+		GranteeSigns:          fact.granteeSigns,
+		Currency:              fact.currency,
+	})
+}
+
+type GrantTemplateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender       string               `json:"sender"`
+	Contract     string               `json:"contract"`
+	TemplateID   string               `json:"template_id"`
+	Grantee      string               `json:"grantee"`
+	GranteeSigns []holderSignUnpacker `json:"grantee_signs"`
+	Currency     string               `json:"currency"`
+}
+
+func (fact *GrantTemplateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GrantTemplateFact")
+
+	var uf GrantTemplateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Grantee,
+		uf.GranteeSigns,
+		uf.Currency,
+	)
+}
+
+type GrantTemplateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op GrantTemplate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(GrantTemplateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *GrantTemplate) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of GrantTemplate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
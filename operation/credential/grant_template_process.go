@@ -0,0 +1,289 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var grantTemplateProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(GrantTemplateProcessor)
+	},
+}
+
+func (GrantTemplate) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type GrantTemplateProcessor struct {
+	*base.BaseOperationProcessor
+	networkID base.NetworkID
+}
+
+func NewGrantTemplateProcessor(networkID base.NetworkID) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new GrantTemplateProcessor")
+
+		nopp := grantTemplateProcessorPool.Get()
+		opp, ok := nopp.(*GrantTemplateProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected GrantTemplateProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.networkID = networkID
+
+		return opp, nil
+	}
+}
+
+func (opp *GrantTemplateProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess GrantTemplate")
+
+	fact, ok := op.Fact().(GrantTemplateFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", GrantTemplateFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found from state, %q; %w", fact.Contract(), err), nil
+	}
+
+	if err := checkServiceRole(ca, fact.Contract(), fact.Sender(), types.RoleTemplateAdmin, fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyTemplate(fact.Contract(), fact.TemplateID()), "key of template", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template not found, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template value not found from state, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	if !bool(template.TemplateShare()) {
+		return nil, base.NewBaseOperationProcessReasonError("template not shareable, %q, %s", fact.TemplateID(), fact.Contract()), nil
+	}
+
+	switch status, err := state.StateTemplateStatusValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("template status not found from state, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	case status == types.TemplateStatusRemoved:
+		return nil, base.NewBaseOperationProcessReasonError("template already removed, %q, %s", fact.TemplateID(), fact.Contract()), nil
+	}
+
+	st, err = currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Grantee()), "key of grantee contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("grantee contract account state not found, %q; %w", fact.Grantee(), err), nil
+	}
+
+	gca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("grantee contract account value not found from state, %q; %w", fact.Grantee(), err), nil
+	}
+
+	if err := checkGranteeSigns(fact, gca.Owner(), opp.networkID, getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid acceptance of grantee owner, %q; %w", gca.Owner(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Grantee()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("grantee credential service state not found, %s; %w", fact.Grantee(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(state.StateKeyTemplate(fact.Grantee(), fact.TemplateID()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("grantee already has template, %q, %s; %w", fact.TemplateID(), fact.Grantee(), err), nil
+	}
+
+	switch st, found, err := getStateFunc(state.StateKeyTemplateGrant(fact.Grantee(), fact.TemplateID())); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get template grant state, %q, %s; %w", fact.TemplateID(), fact.Grantee(), err), nil
+	case found:
+		owner, err := state.StateTemplateGrantValue(st)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("template grant value not found from state, %q, %s; %w", fact.TemplateID(), fact.Grantee(), err), nil
+		}
+
+		return nil, base.NewBaseOperationProcessReasonError("template already granted to grantee by %s, %q, %s", owner, fact.TemplateID(), fact.Grantee()), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *GrantTemplateProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(GrantTemplateFact)
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = currencystate.NewStateMergeValue(
+		state.StateKeyTemplateGrant(fact.Grantee(), fact.TemplateID()),
+		state.NewTemplateGrantStateValue(fact.Contract()),
+	)
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts[1] = currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))))
+
+	return sts, nil, nil
+}
+
+func (opp *GrantTemplateProcessor) Close() error {
+	grantTemplateProcessorPool.Put(opp)
+
+	return nil
+}
+
+// checkGranteeSigns checks the grant is accepted by the owner of grantee
+// contract account.
+func checkGranteeSigns(fact GrantTemplateFact, owner base.Address, networkID base.NetworkID, getStateFunc base.GetStateFunc) error {
+	st, err := currencystate.ExistsState(currency.StateKeyAccount(owner), "key of grantee owner account", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	keys, err := currency.StateKeysValue(st)
+	switch {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get grantee owner account keys")
+	case keys == nil:
+		return errors.Errorf("empty keys of grantee owner account")
+	}
+
+	b := fact.AcceptBytes()
+
+	signs := make([]base.Sign, len(fact.GranteeSigns()))
+	for i, s := range fact.GranteeSigns() {
+		if err := s.Verify(networkID, b); err != nil {
+			return err
+		}
+
+		signs[i] = s
+	}
+
+	return currencytypes.CheckThreshold(signs, keys)
+}
+
+// existsTemplateState returns the template state of the credential service
+// with the owner service of the template; the template granted by the other
+// service is read from the owner service.
+func existsTemplateState(
+	contract base.Address, templateID string, getStateFunc base.GetStateFunc,
+) (base.State, base.Address, error) {
+	switch st, found, err := getStateFunc(state.StateKeyTemplate(contract, templateID)); {
+	case err != nil:
+		return nil, nil, err
+	case found:
+		return st, contract, nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyTemplateGrant(contract, templateID), "key of template", getStateFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	owner, err := state.StateTemplateGrantValue(st)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyTemplate(owner, templateID), "key of template", getStateFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return st, owner, nil
+}
+
+// templateOwner returns the credential service which owns the template of the
+// contract; the grantee service returns the owner of the granted template.
+func templateOwner(contract base.Address, templateID string, getStateFunc base.GetStateFunc) (base.Address, error) {
+	_, owner, err := existsTemplateState(contract, templateID, getStateFunc)
+	if err != nil {
+		return nil, errors.Wrapf(err, "templateID not found, %q", templateID)
+	}
+
+	return owner, nil
+}
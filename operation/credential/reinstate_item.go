@@ -0,0 +1,101 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var ReinstateItemHint = hint.MustNewHint("mitum-credential-reinstate-item-v0.0.1")
+
+type ReinstateItem struct {
+	hint.BaseHinter
+	contract   base.Address
+	holder     base.Address
+	templateID string
+	id         string
+	currency   currencytypes.CurrencyID
+}
+
+func NewReinstateItem(
+	contract base.Address,
+	holder base.Address,
+	templateID, id string,
+	currency currencytypes.CurrencyID,
+) ReinstateItem {
+	return ReinstateItem{
+		BaseHinter: hint.NewBaseHinter(ReinstateItemHint),
+		contract:   contract,
+		holder:     holder,
+		templateID: templateID,
+		id:         id,
+		currency:   currency,
+	}
+}
+
+func (it ReinstateItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.holder.Bytes(),
+		[]byte(it.templateID),
+		[]byte(it.id),
+		it.currency.Bytes(),
+	)
+}
+
+func (it ReinstateItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.holder,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.holder) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", it.holder)
+	}
+
+	if l := utf8.RuneCountInString(it.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(it.id); l < 1 || l > MaxLengthCredentialID {
+		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
+	}
+
+	return nil
+}
+
+func (it ReinstateItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it ReinstateItem) Holder() base.Address {
+	return it.holder
+}
+
+func (it ReinstateItem) TemplateID() string {
+	return it.templateID
+}
+
+func (it ReinstateItem) ID() string {
+	return it.id
+}
+
+func (it ReinstateItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it ReinstateItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.holder
+
+	return ad
+}
@@ -0,0 +1,67 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type ReinstateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender base.Address    `json:"sender"`
+	Items  []ReinstateItem `json:"items"`
+}
+
+func (fact ReinstateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReinstateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Items:                 fact.items,
+	})
+}
+
+type ReinstateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender string          `json:"sender"`
+	Items  json.RawMessage `json:"items"`
+}
+
+func (fact *ReinstateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of ReinstateFact")
+
+	var uf ReinstateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc, uf.Sender, uf.Items)
+}
+
+type ReinstateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Reinstate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(ReinstateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Reinstate) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of Reinstate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func (Reinstate) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+func NewReinstateProcessor(proposedAt ProposedAtFunc) currencytypes.GetNewProcessor {
+	return newCredentialStatusProcessor(
+		"Reinstate", types.CredentialStatusSuspended, types.CredentialStatusActive, proposedAt,
+	)
+}
@@ -0,0 +1,156 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RenounceFactHint = hint.MustNewHint("mitum-credential-renounce-operation-fact-v0.0.1")
+	RenounceHint     = hint.MustNewHint("mitum-credential-renounce-operation-v0.0.1")
+)
+
+// RenounceFact withdraws the credential of the sender, the holder, from the
+// credential service.
+type RenounceFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	id         string
+	reasonCode string
+	reason     string
+	currency   currencytypes.CurrencyID
+}
+
+func NewRenounceFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID, id string,
+	reasonCode, reason string,
+	currency currencytypes.CurrencyID,
+) RenounceFact {
+	bf := base.NewBaseFact(RenounceFactHint, token)
+	fact := RenounceFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		id:         id,
+		reasonCode: reasonCode,
+		reason:     reason,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RenounceFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RenounceFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RenounceFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.id),
+		[]byte(fact.reasonCode),
+		[]byte(fact.reason),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RenounceFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(fact.id); l < 1 || l > MaxLengthCredentialID {
+		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
+	}
+
+	if err := types.IsValidRevocationReason(fact.reasonCode, fact.reason); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RenounceFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RenounceFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RenounceFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RenounceFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RenounceFact) ID() string {
+	return fact.id
+}
+
+func (fact RenounceFact) ReasonCode() string {
+	return fact.reasonCode
+}
+
+func (fact RenounceFact) Reason() string {
+	return fact.reason
+}
+
+func (fact RenounceFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RenounceFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type Renounce struct {
+	common.BaseOperation
+}
+
+func NewRenounce(fact RenounceFact) (Renounce, error) {
+	return Renounce{BaseOperation: common.NewBaseOperation(RenounceHint, fact)}, nil
+}
@@ -0,0 +1,36 @@
+package credential
+
+import (
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RenounceFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, templateID, id, reasonCode, reason, cid string,
+) error {
+	e := util.StringError("failed to unmarshal RenounceFact")
+
+	fact.templateID = templateID
+	fact.id = id
+	fact.reasonCode = reasonCode
+	fact.reason = reason
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
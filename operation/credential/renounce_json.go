@@ -0,0 +1,88 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RenounceFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	ID         string                   `json:"id"`
+	ReasonCode string                   `json:"reason_code,omitempty"`
+	Reason     string                   `json:"reason,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RenounceFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenounceFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		ID:                    fact.id,
+		ReasonCode:            fact.reasonCode,
+		Reason:                fact.reason,
+		Currency:              fact.currency,
+	})
+}
+
+type RenounceFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	ID         string `json:"id"`
+	ReasonCode string `json:"reason_code"`
+	Reason     string `json:"reason"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RenounceFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RenounceFact")
+
+	var uf RenounceFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.ID,
+		uf.ReasonCode,
+		uf.Reason,
+		uf.Currency,
+	)
+}
+
+type RenounceMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op Renounce) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RenounceMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *Renounce) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of Renounce")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,248 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var renounceProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RenounceProcessor)
+	},
+}
+
+func (Renounce) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RenounceProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRenounceProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RenounceProcessor")
+
+		nopp := renounceProcessorPool.Get()
+		opp, ok := nopp.(*RenounceProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RenounceProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RenounceProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess Renounce")
+
+	fact, ok := op.Fact().(RenounceFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", RenounceFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	if _, _, err := existsTemplateState(fact.Contract(), fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("templateID not found, %q; %w", fact.TemplateID(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyCredential(fact.Contract(), fact.TemplateID(), fact.ID()), "key of credential", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential not found, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.ID(), err), nil
+	}
+
+	credential, _, err := state.StateCredentialValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential value not found from state, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.ID(), err), nil
+	}
+
+	if !credential.Holder().Equal(fact.Sender()) {
+		return nil, base.NewBaseOperationProcessReasonError("credential not assigned to sender, %s-%s-%s, %q", fact.Contract(), fact.TemplateID(), fact.ID(), fact.Sender()), nil
+	}
+
+	switch status, err := state.StateCredentialStatusValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("credential status not found from state, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.ID(), err), nil
+	case status.IsWithdrawn():
+		return nil, base.NewBaseOperationProcessReasonError("already %s credential, %s-%s-%s", status, fact.Contract(), fact.TemplateID(), fact.ID()), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RenounceProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(RenounceFact)
+
+	st, _ := currencystate.ExistsState(state.StateKeyCredential(fact.Contract(), fact.TemplateID(), fact.ID()), "key of credential", getStateFunc)
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential value not found from state, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.ID(), err), nil
+	}
+
+	renouncement := types.NewRevocation(fact.ReasonCode(), fact.Reason(), fact.Sender(), opp.Height())
+	cv.Status = types.CredentialStatusRenounced
+	cv.Revocation = &renouncement
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyCredential(fact.Contract(), fact.TemplateID(), fact.ID()),
+			cv,
+		),
+	}
+
+	if cv.StatusIndex != nil {
+		statusLists := newStatusListChunks()
+
+		if err := statusLists.set(fact.Contract(), fact.TemplateID(), *cv.StatusIndex, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError(
+				"failed to set status list, %s-%s; %w", fact.Contract(), fact.TemplateID(), err), nil
+		}
+
+		sts = append(sts, statusLists.states()...)
+	}
+
+	st, _ = currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+	de, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service value not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	credentialCount := de.Policy().CredentialCount()
+	holderCount := de.Policy().HolderCount()
+
+	if credentialCount < 1 {
+		return nil, base.NewBaseOperationProcessReasonError("no credentials to renounce, %s", fact.Contract()), nil
+	}
+	credentialCount--
+
+	holderStats := map[string]*uint64{}
+
+	count, err := serviceHolderStat(fact.Contract(), fact.Sender(), holderStats, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if *count < 1 {
+		return nil, base.NewBaseOperationProcessReasonError("holder not found in credential service holders, %s, %s", fact.Contract(), fact.Sender()), nil
+	}
+
+	*count--
+	if *count == 0 && holderCount > 0 {
+		holderCount--
+	}
+
+	templateStats := map[string]*uint64{}
+	if err := countTemplateCredential(
+		fact.Contract(), fact.TemplateID(), fact.Sender(), false, holderStats, templateStats, getStateFunc,
+	); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	design := types.NewDesign(types.NewPolicy(de.Policy().TemplateCount(), credentialCount, holderCount, de.Policy().LegacyHolders()), de.DIDMethods())
+	if err := design.IsValid(nil); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("invalid design, %s; %w", fact.Contract(), err), nil
+	}
+
+	sts = append(sts, currencystate.NewStateMergeValue(state.StateKeyDesign(fact.Contract()), state.NewDesignStateValue(design)))
+
+	for k, c := range holderStats {
+		sts = append(sts, currencystate.NewStateMergeValue(k, state.NewHolderStatStateValue(*c)))
+	}
+
+	for k, c := range templateStats {
+		sts = append(sts, currencystate.NewStateMergeValue(k, state.NewTemplateStatStateValue(*c)))
+	}
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts = append(sts, currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+
+	return sts, nil, nil
+}
+
+func (opp *RenounceProcessor) Close() error {
+	renounceProcessorPool.Put(opp)
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RevokeBatchFactHint = hint.MustNewHint("mitum-credential-revoke-batch-operation-fact-v0.0.1")
+	RevokeBatchHint     = hint.MustNewHint("mitum-credential-revoke-batch-operation-v0.0.1")
+)
+
+// RevokeBatchFact revokes all the credentials of the anchored batch at once.
+type RevokeBatchFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	batchID    string
+	reasonCode string
+	reason     string
+	currency   currencytypes.CurrencyID
+}
+
+func NewRevokeBatchFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID, batchID string,
+	reasonCode, reason string,
+	currency currencytypes.CurrencyID,
+) RevokeBatchFact {
+	bf := base.NewBaseFact(RevokeBatchFactHint, token)
+	fact := RevokeBatchFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		batchID:    batchID,
+		reasonCode: reasonCode,
+		reason:     reason,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeBatchFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeBatchFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeBatchFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.batchID),
+		[]byte(fact.reasonCode),
+		[]byte(fact.reason),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeBatchFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(fact.batchID); l < 1 || l > MaxLengthBatchID {
+		return util.ErrInvalid.Errorf("invalid length of batch ID, 0 <= length <= %d", MaxLengthBatchID)
+	}
+
+	if err := types.IsValidRevocationReason(fact.reasonCode, fact.reason); err != nil {
+		return err
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RevokeBatchFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeBatchFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeBatchFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeBatchFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RevokeBatchFact) BatchID() string {
+	return fact.batchID
+}
+
+func (fact RevokeBatchFact) ReasonCode() string {
+	return fact.reasonCode
+}
+
+func (fact RevokeBatchFact) Reason() string {
+	return fact.reason
+}
+
+func (fact RevokeBatchFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeBatchFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type RevokeBatch struct {
+	common.BaseOperation
+}
+
+func NewRevokeBatch(fact RevokeBatchFact) (RevokeBatch, error) {
+	return RevokeBatch{BaseOperation: common.NewBaseOperation(RevokeBatchHint, fact)}, nil
+}
@@ -0,0 +1,88 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RevokeBatchFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	BatchID    string                   `json:"batch_id"`
+	ReasonCode string                   `json:"reason_code,omitempty"`
+	Reason     string                   `json:"reason,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeBatchFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeBatchFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		BatchID:               fact.batchID,
+		ReasonCode:            fact.reasonCode,
+		Reason:                fact.reason,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeBatchFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	BatchID    string `json:"batch_id"`
+	ReasonCode string `json:"reason_code"`
+	Reason     string `json:"reason"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RevokeBatchFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeBatchFact")
+
+	var uf RevokeBatchFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.BatchID,
+		uf.ReasonCode,
+		uf.Reason,
+		uf.Currency,
+	)
+}
+
+type RevokeBatchMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeBatch) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeBatchMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeBatch) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeBatch")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var revokeBatchProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(RevokeBatchProcessor)
+	},
+}
+
+func (RevokeBatch) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type RevokeBatchProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewRevokeBatchProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new RevokeBatchProcessor")
+
+		nopp := revokeBatchProcessorPool.Get()
+		opp, ok := nopp.(*RevokeBatchProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected RevokeBatchProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *RevokeBatchProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess RevokeBatch")
+
+	fact, ok := op.Fact().(RevokeBatchFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", RevokeBatchFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found from state, %q; %w", fact.Contract(), err), nil
+	}
+
+	if err := checkServiceRole(ca, fact.Contract(), fact.Sender(), types.RoleRevoker, fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	if _, _, err := existsTemplateState(fact.Contract(), fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("templateID not found, %q; %w", fact.TemplateID(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyBatchAnchor(fact.Contract(), fact.TemplateID(), fact.BatchID()), "key of batch anchor", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("batch not found, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.BatchID(), err), nil
+	}
+
+	switch ba, err := state.StateBatchAnchorValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("batch anchor value not found from state, %s-%s-%s; %w", fact.Contract(), fact.TemplateID(), fact.BatchID(), err), nil
+	case ba.IsRevoked():
+		return nil, base.NewBaseOperationProcessReasonError("already revoked batch, %s-%s-%s", fact.Contract(), fact.TemplateID(), fact.BatchID()), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *RevokeBatchProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(RevokeBatchFact)
+
+	k := state.StateKeyBatchAnchor(fact.Contract(), fact.TemplateID(), fact.BatchID())
+
+	st, _ := currencystate.ExistsState(k, "key of batch anchor", getStateFunc)
+	ba, err := state.StateBatchAnchorValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("batch anchor value not found from state, %s; %w", k, err), nil
+	}
+
+	revocation := types.NewRevocation(fact.ReasonCode(), fact.Reason(), fact.Sender(), opp.Height())
+	ba.Revocation = &revocation
+
+	sts := []base.StateMergeValue{
+		currencystate.NewStateMergeValue(k, ba),
+	}
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts = append(sts, currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee)))))
+
+	return sts, nil, nil
+}
+
+func (opp *RevokeBatchProcessor) Close() error {
+	revokeBatchProcessorPool.Put(opp)
+
+	return nil
+}
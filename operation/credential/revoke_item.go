@@ -3,6 +3,7 @@ package credential
 import (
 	"unicode/utf8"
 
+	"github.com/ProtoconNet/mitum-credential/types"
 	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
@@ -17,6 +18,8 @@ type RevokeItem struct {
 	holder     base.Address
 	templateID string
 	id         string
+	reasonCode string
+	reason     string
 	currency   currencytypes.CurrencyID
 }
 
@@ -24,6 +27,7 @@ func NewRevokeItem(
 	contract base.Address,
 	holder base.Address,
 	templateID, id string,
+	reasonCode, reason string,
 	currency currencytypes.CurrencyID,
 ) RevokeItem {
 	return RevokeItem{
@@ -32,6 +36,8 @@ func NewRevokeItem(
 		holder:     holder,
 		templateID: templateID,
 		id:         id,
+		reasonCode: reasonCode,
+		reason:     reason,
 		currency:   currency,
 	}
 }
@@ -42,6 +48,8 @@ func (it RevokeItem) Bytes() []byte {
 		it.holder.Bytes(),
 		[]byte(it.templateID),
 		[]byte(it.id),
+		[]byte(it.reasonCode),
+		[]byte(it.reason),
 		it.currency.Bytes(),
 	)
 }
@@ -68,7 +76,7 @@ func (it RevokeItem) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
 	}
 
-	return nil
+	return types.IsValidRevocationReason(it.reasonCode, it.reason)
 }
 
 func (it RevokeItem) Contract() base.Address {
@@ -87,6 +95,14 @@ func (it RevokeItem) ID() string {
 	return it.id
 }
 
+func (it RevokeItem) ReasonCode() string {
+	return it.reasonCode
+}
+
+func (it RevokeItem) Reason() string {
+	return it.reason
+}
+
 func (it RevokeItem) Currency() currencytypes.CurrencyID {
 	return it.currency
 }
@@ -15,6 +15,8 @@ func (it RevokeItem) MarshalBSON() ([]byte, error) {
 			"holder":      it.holder,
 			"template_id": it.templateID,
 			"id":          it.id,
+			"reason_code": it.reasonCode,
+			"reason":      it.reason,
 			"currency":    it.currency,
 		},
 	)
@@ -26,6 +28,8 @@ type RevokeItemBSONUnmarshaler struct {
 	Holder     string `bson:"holder"`
 	TemplateID string `bson:"template_id"`
 	ID         string `bson:"id"`
+	ReasonCode string `bson:"reason_code"`
+	Reason     string `bson:"reason"`
 	Currency   string `bson:"currency"`
 }
 
@@ -47,6 +51,8 @@ func (it *RevokeItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		uit.Holder,
 		uit.TemplateID,
 		uit.ID,
+		uit.ReasonCode,
+		uit.Reason,
 		uit.Currency,
 	)
 }
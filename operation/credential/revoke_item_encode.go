@@ -10,12 +10,14 @@ import (
 
 func (it *RevokeItem) unpack(enc encoder.Encoder, ht hint.Hint,
 	cAdr, hAdr, tmplID string,
-	id, cid string,
+	id, reasonCode, reason, cid string,
 ) error {
 	e := util.StringError("failed to unmarshal RevokeItem")
 
 	it.BaseHinter = hint.NewBaseHinter(ht)
 	it.id = id
+	it.reasonCode = reasonCode
+	it.reason = reason
 	it.currency = currencytypes.CurrencyID(cid)
 
 	switch a, err := base.DecodeAddress(cAdr, enc); {
@@ -14,6 +14,8 @@ type RevokeItemJSONMarshaler struct {
 	Holder     base.Address             `json:"holder"`
 	TemplateID string                   `json:"template_id"`
 	ID         string                   `json:"id"`
+	ReasonCode string                   `json:"reason_code,omitempty"`
+	Reason     string                   `json:"reason,omitempty"`
 	Currency   currencytypes.CurrencyID `json:"currency"`
 }
 
@@ -24,6 +26,8 @@ func (it RevokeItem) MarshalJSON() ([]byte, error) {
 		Holder:     it.holder,
 		TemplateID: it.templateID,
 		ID:         it.id,
+		ReasonCode: it.reasonCode,
+		Reason:     it.reason,
 		Currency:   it.currency,
 	})
 }
@@ -34,6 +38,8 @@ type RevokeItemJSONUnmarshaler struct {
 	Holder     string    `json:"holder"`
 	TemplateID string    `json:"template_id"`
 	ID         string    `json:"id"`
+	ReasonCode string    `json:"reason_code"`
+	Reason     string    `json:"reason"`
 	Currency   string    `json:"currency"`
 }
 
@@ -51,6 +57,8 @@ func (it *RevokeItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		uit.Holder,
 		uit.TemplateID,
 		uit.ID,
+		uit.ReasonCode,
+		uit.Reason,
 		uit.Currency,
 	)
 }
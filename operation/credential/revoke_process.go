@@ -38,8 +38,12 @@ type RevokeItemProcessor struct {
 	h               util.Hash
 	sender          base.Address
 	item            RevokeItem
+	height          base.Height
 	credentialCount *uint64
-	holders         *[]types.Holder
+	holderCount     *uint64
+	holderStats     map[string]*uint64
+	templateStats   map[string]*uint64
+	statusLists     *statusListChunks
 }
 
 func (ipp *RevokeItemProcessor) PreProcess(
@@ -69,11 +73,8 @@ func (ipp *RevokeItemProcessor) PreProcess(
 		return err
 	}
 
-	if !(ca.Owner().Equal(ipp.sender) || ca.IsOperator(ipp.sender)) {
-		return errors.Errorf(
-			"sender is neither the owner nor the operator of the target contract account, %q",
-			ipp.sender,
-		)
+	if err := checkServiceRole(ca, it.Contract(), ipp.sender, types.RoleRevoker, it.TemplateID(), getStateFunc); err != nil {
+		return err
 	}
 
 	if st, err := currencystate.ExistsState(state.StateKeyDesign(it.Contract()), "key of design", getStateFunc); err != nil {
@@ -84,14 +85,10 @@ func (ipp *RevokeItemProcessor) PreProcess(
 		if err := de.IsValid(nil); err != nil {
 			return err
 		}
-		for i, v := range de.Policy().TemplateIDs() {
-			if it.templateID == v {
-				break
-			}
-			if i == len(de.Policy().TemplateIDs())-1 {
-				return errors.Errorf("templateID not found")
-			}
-		}
+	}
+
+	if _, _, err := existsTemplateState(it.Contract(), it.TemplateID(), getStateFunc); err != nil {
+		return errors.Wrapf(err, "templateID not found, %q", it.TemplateID())
 	}
 
 	st, err = currencystate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()), "key of credential", getStateFunc)
@@ -99,13 +96,20 @@ func (ipp *RevokeItemProcessor) PreProcess(
 		return err
 	}
 
-	credential, isActive, err := state.StateCredentialValue(st)
+	credential, _, err := state.StateCredentialValue(st)
 	if err != nil {
 		return err
 	}
 
-	if !isActive {
-		return errors.Errorf("already revoked credential, %s-%s, %s", it.Contract(), it.ID(), credential.Holder())
+	switch status, err := state.StateCredentialStatusValue(st); {
+	case err != nil:
+		return err
+	case status.IsWithdrawn():
+		return errors.Errorf("already %s credential, %s-%s, %s", status, it.Contract(), it.ID(), credential.Holder())
+	}
+
+	if !credential.Holder().Equal(it.Holder()) {
+		return errors.Errorf("credential not assigned to holder, %s-%s, %s", it.Contract(), it.ID(), it.Holder())
 	}
 
 	if err := currencystate.CheckExistsState(statecurrency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
@@ -125,41 +129,53 @@ func (ipp *RevokeItemProcessor) Process(
 
 	*ipp.credentialCount--
 
-	if len(*ipp.holders) < 1 {
-		return nil, errors.Errorf("empty holders, %s", it.Contract())
-	}
-
 	st, _ := currencystate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()), "key of credential", getStateFunc)
-	credential, _, _ := state.StateCredentialValue(st)
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
 
-	if err := credential.IsValid(nil); err != nil {
+	if err := cv.Credential.IsValid(nil); err != nil {
 		return nil, err
 	}
 
+	revocation := types.NewRevocation(it.ReasonCode(), it.Reason(), ipp.sender, ipp.height)
+	cv.Status = types.CredentialStatusRevoked
+	cv.Revocation = &revocation
+
 	sts := []base.StateMergeValue{
 		currencystate.NewStateMergeValue(
 			state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()),
-			state.NewCredentialStateValue(credential, false),
+			cv,
 		),
 	}
 
-	var holders []types.Holder
-	for i, h := range *ipp.holders {
-		if h.Address().Equal(it.Holder()) {
-			if h.CredentialCount()-1 == 0 {
-				copy(holders, (*ipp.holders)[:i])
-				copy(holders, (*ipp.holders)[i+1:])
-				ipp.holders = &holders
-			} else {
-				(*ipp.holders)[i] = types.NewHolder(h.Address(), h.CredentialCount()-1)
-			}
-			break
+	if cv.StatusIndex != nil {
+		if err := ipp.statusLists.set(it.Contract(), it.TemplateID(), *cv.StatusIndex, getStateFunc); err != nil {
+			return nil, err
 		}
+	}
 
-		if i == len(holders)-1 {
-			return nil, errors.Errorf("holder not found in credential service holders, %s, %s", it.Contract(), it.Holder())
-		}
+	count, err := serviceHolderStat(it.Contract(), it.Holder(), ipp.holderStats, getStateFunc)
+	if err != nil {
+		return nil, err
 	}
+
+	if *count < 1 {
+		return nil, errors.Errorf("holder not found in credential service holders, %s, %s", it.Contract(), it.Holder())
+	}
+
+	*count--
+	if *count == 0 && *ipp.holderCount > 0 {
+		*ipp.holderCount--
+	}
+
+	if err := countTemplateCredential(
+		it.Contract(), it.TemplateID(), cv.Credential.Holder(), false, ipp.holderStats, ipp.templateStats, getStateFunc,
+	); err != nil {
+		return nil, err
+	}
+
 	return sts, nil
 }
 
@@ -167,8 +183,12 @@ func (ipp *RevokeItemProcessor) Close() {
 	ipp.h = nil
 	ipp.sender = nil
 	ipp.item = RevokeItem{}
+	ipp.height = base.NilHeight
 	ipp.credentialCount = nil
-	ipp.holders = nil
+	ipp.holderCount = nil
+	ipp.holderStats = nil
+	ipp.templateStats = nil
+	ipp.statusLists = nil
 
 	revokeItemProcessorPool.Put(ipp)
 }
@@ -241,7 +261,8 @@ func (opp *RevokeProcessor) PreProcess(
 		ipc.sender = fact.Sender()
 		ipc.item = it
 		ipc.credentialCount = nil
-		ipc.holders = nil
+		ipc.holderCount = nil
+		ipc.holderStats = nil
 
 		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess RevokeItem; %w", err), nil
@@ -266,7 +287,10 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 
 	designs := map[string]types.Design{}
 	counters := map[string]*uint64{}
-	holders := map[string]*[]types.Holder{}
+	holderCounters := map[string]*uint64{}
+	holderStats := map[string]*uint64{}
+	templateStats := map[string]*uint64{}
+	statusLists := newStatusListChunks()
 
 	for _, it := range fact.Items() {
 		k := state.StateKeyDesign(it.Contract())
@@ -285,10 +309,10 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 		designs[k] = design
 
 		count := design.Policy().CredentialCount()
-		holder := design.Policy().Holders()
+		holderCount := design.Policy().HolderCount()
 
 		counters[k] = &count
-		holders[k] = &holder
+		holderCounters[k] = &holderCount
 	}
 
 	var sts []base.StateMergeValue // nolint:prealloc
@@ -305,22 +329,25 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 		ipc.h = op.Hash()
 		ipc.sender = fact.Sender()
 		ipc.item = it
+		ipc.height = opp.Height()
 		ipc.credentialCount = counters[k]
-		ipc.holders = holders[k]
+		ipc.holderCount = holderCounters[k]
+		ipc.holderStats = holderStats
+		ipc.templateStats = templateStats
+		ipc.statusLists = statusLists
 
 		st, err := ipc.Process(ctx, op, getStateFunc)
 		if err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("failed to process RevokeItem; %w", err), nil
 		}
 
-		holders[k] = ipc.holders
 		sts = append(sts, st...)
 		ipc.Close()
 	}
 
 	for k, de := range designs {
-		policy := types.NewPolicy(de.Policy().TemplateIDs(), *holders[k], *counters[k])
-		design := types.NewDesign(policy)
+		policy := types.NewPolicy(de.Policy().TemplateCount(), *counters[k], *holderCounters[k], de.Policy().LegacyHolders())
+		design := types.NewDesign(policy, de.DIDMethods())
 		if err := design.IsValid(nil); err != nil {
 			return nil, base.NewBaseOperationProcessReasonError("invalid design, %s; %w", k, err), nil
 		}
@@ -333,6 +360,26 @@ func (opp *RevokeProcessor) Process( // nolint:dupl
 		)
 	}
 
+	for k, count := range holderStats {
+		sts = append(sts,
+			currencystate.NewStateMergeValue(
+				k,
+				state.NewHolderStatStateValue(*count),
+			),
+		)
+	}
+
+	for k, count := range templateStats {
+		sts = append(sts,
+			currencystate.NewStateMergeValue(
+				k,
+				state.NewTemplateStatStateValue(*count),
+			),
+		)
+	}
+
+	sts = append(sts, statusLists.states()...)
+
 	items := make([]CredentialItem, len(fact.Items()))
 	for i := range fact.Items() {
 		items[i] = fact.Items()[i]
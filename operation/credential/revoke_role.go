@@ -0,0 +1,148 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	RevokeRoleFactHint = hint.MustNewHint("mitum-credential-revoke-role-operation-fact-v0.0.1")
+	RevokeRoleHint     = hint.MustNewHint("mitum-credential-revoke-role-operation-v0.0.1")
+)
+
+// RevokeRoleFact takes the role given by GrantRole from the account.
+type RevokeRoleFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	account    base.Address
+	role       types.Role
+	currency   currencytypes.CurrencyID
+}
+
+func NewRevokeRoleFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	account base.Address,
+	role types.Role,
+	currency currencytypes.CurrencyID,
+) RevokeRoleFact {
+	bf := base.NewBaseFact(RevokeRoleFactHint, token)
+	fact := RevokeRoleFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		account:    account,
+		role:       role,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact RevokeRoleFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact RevokeRoleFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact RevokeRoleFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.account.Bytes(),
+		fact.role.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact RevokeRoleFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.account,
+		fact.role,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if fact.account.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("account address is same with contract, %q", fact.account)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact RevokeRoleFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact RevokeRoleFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact RevokeRoleFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact RevokeRoleFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact RevokeRoleFact) Account() base.Address {
+	return fact.account
+}
+
+func (fact RevokeRoleFact) Role() types.Role {
+	return fact.role
+}
+
+func (fact RevokeRoleFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact RevokeRoleFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 3)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	as[2] = fact.account
+	return as, nil
+}
+
+type RevokeRole struct {
+	common.BaseOperation
+}
+
+func NewRevokeRole(fact RevokeRoleFact) (RevokeRole, error) {
+	return RevokeRole{BaseOperation: common.NewBaseOperation(RevokeRoleHint, fact)}, nil
+}
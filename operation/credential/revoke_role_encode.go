@@ -0,0 +1,42 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *RevokeRoleFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID, aAdr, role, cid string,
+) error {
+	e := util.StringError("failed to unmarshal RevokeRoleFact")
+
+	fact.templateID = tmplID
+	fact.role = types.Role(role)
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	switch a, err := base.DecodeAddress(aAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.account = a
+	}
+
+	return nil
+}
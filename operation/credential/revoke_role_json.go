@@ -0,0 +1,85 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type RevokeRoleFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Account    base.Address             `json:"account"`
+	Role       types.Role               `json:"role"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact RevokeRoleFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeRoleFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Account:               fact.account,
+		Role:                  fact.role,
+		Currency:              fact.currency,
+	})
+}
+
+type RevokeRoleFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	Account    string `json:"account"`
+	Role       string `json:"role"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *RevokeRoleFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeRoleFact")
+
+	var uf RevokeRoleFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Account,
+		uf.Role,
+		uf.Currency,
+	)
+}
+
+type RevokeRoleMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op RevokeRole) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevokeRoleMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *RevokeRole) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RevokeRole")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
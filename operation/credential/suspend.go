@@ -0,0 +1,145 @@
+package credential
+
+import (
+	"fmt"
+
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	SuspendFactHint = hint.MustNewHint("mitum-credential-suspend-operation-fact-v0.0.1")
+	SuspendHint     = hint.MustNewHint("mitum-credential-suspend-operation-v0.0.1")
+)
+
+var MaxSuspendItems uint = 10
+
+type SuspendFact struct {
+	base.BaseFact
+	sender base.Address
+	items  []SuspendItem
+}
+
+func NewSuspendFact(token []byte, sender base.Address, items []SuspendItem) SuspendFact {
+	bf := base.NewBaseFact(SuspendFactHint, token)
+	fact := SuspendFact{
+		BaseFact: bf,
+		sender:   sender,
+		items:    items,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact SuspendFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact SuspendFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact SuspendFact) Bytes() []byte {
+	is := make([][]byte, len(fact.items))
+	for i := range fact.items {
+		is[i] = fact.items[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		util.ConcatBytesSlice(is...),
+	)
+}
+
+func (fact SuspendFact) IsValid(b []byte) error {
+	if err := fact.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := len(fact.items); n < 1 {
+		return util.ErrInvalid.Errorf("empty items")
+	} else if n > int(MaxSuspendItems) {
+		return util.ErrInvalid.Errorf("items, %d over max, %d", n, MaxSuspendItems)
+	}
+
+	if err := fact.sender.IsValid(nil); err != nil {
+		return err
+	}
+
+	founds := map[string]struct{}{}
+	for _, it := range fact.items {
+		if err := it.IsValid(nil); err != nil {
+			return err
+		}
+
+		if it.contract.Equal(fact.sender) {
+			return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+		}
+
+		k := fmt.Sprintf("%s-%s-%s", it.contract, it.templateID, it.id)
+
+		if _, found := founds[k]; found {
+			return util.ErrInvalid.Errorf("duplicate credential id found, %s", k)
+		}
+
+		founds[k] = struct{}{}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact SuspendFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact SuspendFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact SuspendFact) Items() []SuspendItem {
+	return fact.items
+}
+
+func (fact SuspendFact) StatusItems() []CredentialStatusItem {
+	items := make([]CredentialStatusItem, len(fact.items))
+	for i := range fact.items {
+		items[i] = fact.items[i]
+	}
+
+	return items
+}
+
+func (fact SuspendFact) Addresses() ([]base.Address, error) {
+	as := []base.Address{}
+
+	adrMap := make(map[string]struct{})
+	for i := range fact.items {
+		for j := range fact.items[i].Addresses() {
+			if _, found := adrMap[fact.items[i].Addresses()[j].String()]; !found {
+				adrMap[fact.items[i].Addresses()[j].String()] = struct{}{}
+				as = append(as, fact.items[i].Addresses()[j])
+			}
+		}
+	}
+	as = append(as, fact.sender)
+
+	return as, nil
+}
+
+type Suspend struct {
+	common.BaseOperation
+}
+
+func NewSuspend(fact SuspendFact) (Suspend, error) {
+	return Suspend{BaseOperation: common.NewBaseOperation(SuspendHint, fact)}, nil
+}
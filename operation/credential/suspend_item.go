@@ -0,0 +1,101 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var SuspendItemHint = hint.MustNewHint("mitum-credential-suspend-item-v0.0.1")
+
+type SuspendItem struct {
+	hint.BaseHinter
+	contract   base.Address
+	holder     base.Address
+	templateID string
+	id         string
+	currency   currencytypes.CurrencyID
+}
+
+func NewSuspendItem(
+	contract base.Address,
+	holder base.Address,
+	templateID, id string,
+	currency currencytypes.CurrencyID,
+) SuspendItem {
+	return SuspendItem{
+		BaseHinter: hint.NewBaseHinter(SuspendItemHint),
+		contract:   contract,
+		holder:     holder,
+		templateID: templateID,
+		id:         id,
+		currency:   currency,
+	}
+}
+
+func (it SuspendItem) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		it.holder.Bytes(),
+		[]byte(it.templateID),
+		[]byte(it.id),
+		it.currency.Bytes(),
+	)
+}
+
+func (it SuspendItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.holder,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.contract.Equal(it.holder) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", it.holder)
+	}
+
+	if l := utf8.RuneCountInString(it.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(it.id); l < 1 || l > MaxLengthCredentialID {
+		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
+	}
+
+	return nil
+}
+
+func (it SuspendItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it SuspendItem) Holder() base.Address {
+	return it.holder
+}
+
+func (it SuspendItem) TemplateID() string {
+	return it.templateID
+}
+
+func (it SuspendItem) ID() string {
+	return it.id
+}
+
+func (it SuspendItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it SuspendItem) Addresses() []base.Address {
+	ad := make([]base.Address, 2)
+
+	ad[0] = it.contract
+	ad[1] = it.holder
+
+	return ad
+}
@@ -0,0 +1,21 @@
+package credential
+
+import (
+	"context"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func (Suspend) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+func NewSuspendProcessor(proposedAt ProposedAtFunc) currencytypes.GetNewProcessor {
+	return newCredentialStatusProcessor(
+		"Suspend", types.CredentialStatusActive, types.CredentialStatusSuspended, proposedAt,
+	)
+}
@@ -0,0 +1,141 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var UpdateCredentialItemHint = hint.MustNewHint("mitum-credential-update-credential-item-v0.0.1")
+
+type UpdateCredentialItem struct {
+	hint.BaseHinter
+	contract   base.Address
+	templateID string
+	id         string
+	value      string
+	validFrom  uint64
+	validUntil uint64
+	issuerSign *types.IssuerSign
+	currency   currencytypes.CurrencyID
+}
+
+func NewUpdateCredentialItem(
+	contract base.Address,
+	templateID string,
+	id string,
+	value string,
+	validFrom uint64,
+	validUntil uint64,
+	issuerSign *types.IssuerSign,
+	currency currencytypes.CurrencyID,
+) UpdateCredentialItem {
+	return UpdateCredentialItem{
+		BaseHinter: hint.NewBaseHinter(UpdateCredentialItemHint),
+		contract:   contract,
+		templateID: templateID,
+		id:         id,
+		value:      value,
+		validFrom:  validFrom,
+		validUntil: validUntil,
+		issuerSign: issuerSign,
+		currency:   currency,
+	}
+}
+
+func (it UpdateCredentialItem) Bytes() []byte {
+	var sign []byte
+	if it.issuerSign != nil {
+		sign = it.issuerSign.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		it.contract.Bytes(),
+		[]byte(it.templateID),
+		[]byte(it.id),
+		[]byte(it.value),
+		util.Uint64ToBytes(it.validFrom),
+		util.Uint64ToBytes(it.validUntil),
+		sign,
+		it.currency.Bytes(),
+	)
+}
+
+func (it UpdateCredentialItem) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		it.BaseHinter,
+		it.contract,
+		it.currency,
+	); err != nil {
+		return err
+	}
+
+	if it.validUntil <= it.validFrom {
+		return util.ErrInvalid.Errorf("valid until <= valid from, %q <= %q", it.validUntil, it.validFrom)
+	}
+
+	if l := utf8.RuneCountInString(it.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(it.id); l < 1 || l > MaxLengthCredentialID {
+		return util.ErrInvalid.Errorf("invalid length of ID, 0 <= length <= %d", MaxLengthCredentialID)
+	}
+
+	if l := utf8.RuneCountInString(it.value); l < 1 || l > MaxLengthCredentialValue {
+		return util.ErrInvalid.Errorf("invalid length of value, 0 <= length <= %d", MaxLengthCredentialValue)
+	}
+
+	if it.issuerSign != nil {
+		if err := it.issuerSign.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (it UpdateCredentialItem) Contract() base.Address {
+	return it.contract
+}
+
+func (it UpdateCredentialItem) TemplateID() string {
+	return it.templateID
+}
+
+func (it UpdateCredentialItem) ID() string {
+	return it.id
+}
+
+func (it UpdateCredentialItem) Value() string {
+	return it.value
+}
+
+func (it UpdateCredentialItem) ValidFrom() uint64 {
+	return it.validFrom
+}
+
+func (it UpdateCredentialItem) ValidUntil() uint64 {
+	return it.validUntil
+}
+
+// IssuerSign returns the issuer sign over the updated credential.
+func (it UpdateCredentialItem) IssuerSign() *types.IssuerSign {
+	return it.issuerSign
+}
+
+func (it UpdateCredentialItem) Currency() currencytypes.CurrencyID {
+	return it.currency
+}
+
+func (it UpdateCredentialItem) Addresses() []base.Address {
+	ad := make([]base.Address, 1)
+
+	ad[0] = it.contract
+
+	return ad
+}
@@ -0,0 +1,73 @@
+package credential // nolint:dupl
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (it UpdateCredentialItem) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":       it.Hint().String(),
+		"contract":    it.contract,
+		"template_id": it.templateID,
+		"id":          it.id,
+		"value":       it.value,
+		"valid_from":  it.validFrom,
+		"valid_until": it.validUntil,
+		"currency":    it.currency,
+	}
+
+	if it.issuerSign != nil {
+		m["issuer_sign"] = it.issuerSign
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type UpdateCredentialItemBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Contract   string   `bson:"contract"`
+	TemplateID string   `bson:"template_id"`
+	ID         string   `bson:"id"`
+	Value      string   `bson:"value"`
+	ValidFrom  uint64   `bson:"valid_from"`
+	ValidUntil uint64   `bson:"valid_until"`
+	IssuerSign bson.Raw `bson:"issuer_sign,omitempty"`
+	Currency   string   `bson:"currency"`
+}
+
+func (it *UpdateCredentialItem) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of UpdateCredentialItem")
+
+	var uit UpdateCredentialItemBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uit.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	var issuerSign *types.IssuerSign
+	if len(uit.IssuerSign) > 0 {
+		issuerSign = new(types.IssuerSign)
+		if err := issuerSign.DecodeBSON(uit.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return it.unpack(enc, ht,
+		uit.Contract,
+		uit.TemplateID,
+		uit.ID,
+		uit.Value,
+		uit.ValidFrom,
+		uit.ValidUntil,
+		issuerSign,
+		uit.Currency,
+	)
+}
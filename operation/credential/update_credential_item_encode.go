@@ -0,0 +1,40 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (it *UpdateCredentialItem) unpack(enc encoder.Encoder, ht hint.Hint,
+	cAdr, tmplID string,
+	id string,
+	val string,
+	vFrom, vUntil uint64,
+	issuerSign *types.IssuerSign,
+	cid string,
+) error {
+	e := util.StringError("failed to unmarshal UpdateCredentialItem")
+
+	it.BaseHinter = hint.NewBaseHinter(ht)
+	it.id = id
+	it.value = val
+	it.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		it.contract = a
+	}
+
+	it.templateID = tmplID
+	it.validFrom = vFrom
+	it.validUntil = vUntil
+	it.issuerSign = issuerSign
+
+	return nil
+}
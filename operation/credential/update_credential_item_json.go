@@ -0,0 +1,79 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type UpdateCredentialItemJSONMarshaler struct {
+	hint.BaseHinter
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	ID         string                   `json:"id"`
+	Value      string                   `json:"value"`
+	ValidFrom  uint64                   `json:"valid_from"`
+	ValidUntil uint64                   `json:"valid_until"`
+	IssuerSign *types.IssuerSign        `json:"issuer_sign,omitempty"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (it UpdateCredentialItem) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateCredentialItemJSONMarshaler{
+		BaseHinter: it.BaseHinter,
+		Contract:   it.contract,
+		TemplateID: it.templateID,
+		ID:         it.id,
+		Value:      it.value,
+		ValidFrom:  it.validFrom,
+		ValidUntil: it.validUntil,
+		IssuerSign: it.issuerSign,
+		Currency:   it.currency,
+	})
+}
+
+type UpdateCredentialItemJSONUnMarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Contract   string          `json:"contract"`
+	TemplateID string          `json:"template_id"`
+	ID         string          `json:"id"`
+	Value      string          `json:"value"`
+	ValidFrom  uint64          `json:"valid_from"`
+	ValidUntil uint64          `json:"valid_until"`
+	IssuerSign json.RawMessage `json:"issuer_sign"`
+	Currency   string          `json:"currency"`
+}
+
+func (it *UpdateCredentialItem) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateCredentialItem")
+
+	var uit UpdateCredentialItemJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uit); err != nil {
+		return e.Wrap(err)
+	}
+
+	var issuerSign *types.IssuerSign
+	if len(uit.IssuerSign) > 0 && string(uit.IssuerSign) != "null" {
+		issuerSign = new(types.IssuerSign)
+		if err := issuerSign.DecodeJSON(uit.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return it.unpack(enc,
+		uit.Hint,
+		uit.Contract,
+		uit.TemplateID,
+		uit.ID,
+		uit.Value,
+		uit.ValidFrom,
+		uit.ValidUntil,
+		issuerSign,
+		uit.Currency,
+	)
+}
@@ -0,0 +1,354 @@
+package credential
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/operation/currency"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	statecurrency "github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	"github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var updateCredentialItemProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateCredentialItemProcessor)
+	},
+}
+
+var updateCredentialProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateCredentialProcessor)
+	},
+}
+
+func (UpdateCredential) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateCredentialItemProcessor struct {
+	h          util.Hash
+	sender     base.Address
+	item       UpdateCredentialItem
+	proposedAt time.Time
+	networkID  base.NetworkID
+}
+
+func (ipp *UpdateCredentialItemProcessor) PreProcess(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) error {
+	it := ipp.item
+
+	if err := it.IsValid(nil); err != nil {
+		return err
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.StateKeyCurrencyDesign(it.Currency()), getStateFunc); err != nil {
+		return errors.Errorf("failed to get fee Currency %s state", it.Currency())
+	}
+
+	st, err := currencystate.ExistsState(extension.StateKeyContractAccount(it.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get target contract account %s state", it.Contract())
+	}
+
+	ca, err := extension.StateContractAccountValue(st)
+	if err != nil {
+		return errors.Wrap(err, "failed to get contract account value from state")
+	}
+
+	if err := checkServiceRole(ca, it.Contract(), ipp.sender, types.RoleIssuer, it.TemplateID(), getStateFunc); err != nil {
+		return err
+	}
+
+	if st, err := currencystate.ExistsState(state.StateKeyDesign(it.Contract()), "key of design", getStateFunc); err != nil {
+		return errors.Wrapf(err, "failed to get design state of credential service")
+	} else if de, err := state.StateDesignValue(st); err != nil {
+		return errors.Wrapf(err, "failed to get design value of credential service from state")
+	} else {
+		if err := de.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	st, _, err = existsTemplateState(it.Contract(), it.TemplateID(), getStateFunc)
+	if err != nil {
+		return errors.Wrapf(err, "templateID not found, %q", it.TemplateID())
+	}
+
+	switch status, err := state.StateTemplateStatusValue(st); {
+	case err != nil:
+		return errors.Wrapf(err, "failed to get template status from state, %q", it.TemplateID())
+	case status == types.TemplateStatusRemoved:
+		return errors.Errorf("template is %s, %q", status, it.TemplateID())
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get template value from state, %q", it.TemplateID())
+	}
+
+	// NOTE the updated value is neither approved by the auditors nor consented
+	// by holder.
+	switch {
+	case bool(template.MultiAudit()):
+		return errors.Errorf("update of credential of multi-audit template not allowed, %q", it.TemplateID())
+	case bool(template.ConsentRequired()):
+		return errors.Errorf("update of credential of consent required template not allowed, %q", it.TemplateID())
+	}
+
+	if err := checkTemplateServicePeriod(template, ipp.proposedAt, it.ValidFrom(), it.ValidUntil()); err != nil {
+		return err
+	}
+
+	if err := checkCredentialValue(template, it.Value()); err != nil {
+		return errors.WithMessagef(err, "invalid value for template, %q", it.TemplateID())
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()), "key of credential", getStateFunc)
+	if err != nil {
+		return err
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return err
+	}
+
+	credential := cv.Credential
+
+	switch status, err := state.StateCredentialStatusValue(st); {
+	case err != nil:
+		return err
+	case status != types.CredentialStatusActive:
+		return errors.Errorf("%s credential, %s-%s-%s, %s", status, it.Contract(), it.TemplateID(), it.ID(), credential.Holder())
+	}
+
+	// NOTE the issuer sign over the previous credential is no longer valid;
+	// the signed credential keeps signed only with the sign over the updated
+	// credential.
+	switch sign := it.IssuerSign(); {
+	case sign != nil:
+		if err := checkIssuerSign(ca, it.Contract(), updatedCredential(credential, it), *sign, ipp.networkID, getStateFunc); err != nil {
+			return errors.WithMessagef(err, "invalid issuer sign, %q", it.ID())
+		}
+	case cv.IssuerSign != nil:
+		return errors.Errorf("issuer sign over updated credential required for signed credential, %q", it.ID())
+	}
+
+	return nil
+}
+
+func (ipp *UpdateCredentialItemProcessor) Process(
+	_ context.Context, _ base.Operation, getStateFunc base.GetStateFunc,
+) ([]base.StateMergeValue, error) {
+	it := ipp.item
+
+	st, err := currencystate.ExistsState(state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()), "key of credential", getStateFunc)
+	if err != nil {
+		return nil, err
+	}
+
+	cv, err := state.StateCredentialStateValue(st)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := updatedCredential(cv.Credential, it)
+	if err := credential.IsValid(nil); err != nil {
+		return nil, err
+	}
+
+	cv.Credential = credential
+	cv.Version++
+	cv.IssuerSign = it.IssuerSign()
+
+	return []base.StateMergeValue{
+		currencystate.NewStateMergeValue(
+			state.StateKeyCredential(it.Contract(), it.TemplateID(), it.ID()),
+			cv,
+		),
+	}, nil
+}
+
+func (ipp *UpdateCredentialItemProcessor) Close() {
+	ipp.h = nil
+	ipp.sender = nil
+	ipp.item = UpdateCredentialItem{}
+	ipp.proposedAt = time.Time{}
+	ipp.networkID = nil
+
+	updateCredentialItemProcessorPool.Put(ipp)
+}
+
+// updatedCredential returns the credential updated by the item; the holder
+// and the did are kept.
+func updatedCredential(prev types.Credential, it UpdateCredentialItem) types.Credential {
+	return types.NewCredential(prev.Holder(), it.TemplateID(), it.ID(), it.Value(), it.ValidFrom(), it.ValidUntil(), prev.DID())
+}
+
+type UpdateCredentialProcessor struct {
+	*base.BaseOperationProcessor
+	proposedAt ProposedAtFunc
+	networkID  base.NetworkID
+}
+
+func NewUpdateCredentialProcessor(proposedAt ProposedAtFunc, networkID base.NetworkID) currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateCredentialProcessor")
+
+		nopp := updateCredentialProcessorPool.Get()
+		opp, ok := nopp.(*UpdateCredentialProcessor)
+		if !ok {
+			return nil, e.Errorf("expected UpdateCredentialProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+		opp.proposedAt = proposedAt
+		opp.networkID = networkID
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateCredentialProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess UpdateCredential")
+
+	fact, ok := op.Fact().(UpdateCredentialFact)
+	if !ok {
+		return ctx, nil, e.Errorf("expected UpdateCredentialFact, not %T", op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(statecurrency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("sender not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckNotExistsState(extension.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("contract account cannot update credential, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.sender, op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	proposedAt, err := opp.proposedAt(opp.Height())
+	if err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	for _, it := range fact.Items() {
+		ip := updateCredentialItemProcessorPool.Get()
+		ipc, ok := ip.(*UpdateCredentialItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected UpdateCredentialItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+		ipc.proposedAt = proposedAt
+		ipc.networkID = opp.networkID
+
+		if err := ipc.PreProcess(ctx, op, getStateFunc); err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to preprocess UpdateCredentialItem; %w", err), nil
+		}
+
+		ipc.Close()
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateCredentialProcessor) Process( // nolint:dupl
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	e := util.StringError("failed to process UpdateCredential")
+
+	fact, ok := op.Fact().(UpdateCredentialFact)
+	if !ok {
+		return nil, nil, e.Errorf("expected UpdateCredentialFact, not %T", op.Fact())
+	}
+
+	var sts []base.StateMergeValue // nolint:prealloc
+
+	for _, it := range fact.Items() {
+		ip := updateCredentialItemProcessorPool.Get()
+		ipc, ok := ip.(*UpdateCredentialItemProcessor)
+		if !ok {
+			return nil, nil, e.Errorf("expected UpdateCredentialItemProcessor, not %T", ip)
+		}
+
+		ipc.h = op.Hash()
+		ipc.sender = fact.Sender()
+		ipc.item = it
+
+		st, err := ipc.Process(ctx, op, getStateFunc)
+		if err != nil {
+			return nil, base.NewBaseOperationProcessReasonError("failed to process UpdateCredentialItem; %w", err), nil
+		}
+
+		sts = append(sts, st...)
+		ipc.Close()
+	}
+
+	items := make([]CredentialItem, len(fact.Items()))
+	for i := range fact.Items() {
+		items[i] = fact.Items()[i]
+	}
+
+	required, err := calculateCredentialItemsFee(getStateFunc, items)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to calculate fee; %w", err), nil
+	}
+	sb, err := currency.CheckEnoughBalance(fact.sender, required, getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check enough balance; %w", err), nil
+	}
+
+	for i := range sb {
+		v, ok := sb[i].Value().(statecurrency.BalanceStateValue)
+		if !ok {
+			return nil, nil, e.Errorf("expected BalanceStateValue, not %T", sb[i].Value())
+		}
+		stv := statecurrency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(required[i][0])))
+		sts = append(sts, currencystate.NewStateMergeValue(sb[i].Key(), stv))
+	}
+
+	return sts, nil, nil
+}
+
+func (opp *UpdateCredentialProcessor) Close() error {
+	opp.proposedAt = nil
+	opp.networkID = nil
+
+	updateCredentialProcessorPool.Put(opp)
+
+	return nil
+}
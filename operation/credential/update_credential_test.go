@@ -0,0 +1,91 @@
+package credential
+
+import (
+	"testing"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestUpdateCredentialIssuerSign(t *testing.T) {
+	networkID := base.NetworkID("test")
+	priv := base.NewMPrivatekey()
+	contract := currencytypes.NewAddress("contract")
+	holder := currencytypes.NewAddress("holder")
+
+	prev := types.NewCredential(holder, "template", "id", "value", 1, 2, "did")
+
+	newItem := func(sign *types.IssuerSign) UpdateCredentialItem {
+		return NewUpdateCredentialItem(contract, "template", "id", "value0", 1, 3, sign, currencytypes.CurrencyID("MCC"))
+	}
+
+	// the issuer signs the credential after update; the holder and the did
+	// are kept from the previous credential.
+	updated := updatedCredential(prev, newItem(nil))
+
+	if !updated.Holder().Equal(holder) || updated.DID() != "did" {
+		t.Fatal("holder or did not kept")
+	}
+
+	if updated.Value() != "value0" || updated.ValidUntil() != 3 {
+		t.Fatal("credential not updated")
+	}
+
+	sign, err := types.NewIssuerSignFromCredential(priv, networkID, contract, updated)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	it := newItem(&sign)
+
+	if err := it.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := it.IssuerSign().Verify(networkID, contract, updatedCredential(prev, it)); err != nil {
+		t.Fatal(err)
+	}
+
+	signOf := func(c types.Credential) *types.IssuerSign {
+		s, err := types.NewIssuerSignFromCredential(priv, networkID, contract, c)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &s
+	}
+
+	cases := []struct {
+		name string
+		sign *types.IssuerSign
+		prev types.Credential
+	}{
+		{name: "sign of previous credential", sign: signOf(prev), prev: prev},
+		{
+			name: "sign without holder and did",
+			sign: signOf(types.NewCredential(nil, "template", "id", "value0", 1, 3, "")),
+			prev: prev,
+		},
+		{
+			name: "other holder",
+			sign: &sign,
+			prev: types.NewCredential(currencytypes.NewAddress("holder0"), "template", "id", "value", 1, 2, "did"),
+		},
+		{
+			name: "other did",
+			sign: &sign,
+			prev: types.NewCredential(holder, "template", "id", "value", 1, 2, "did0"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			it := newItem(tc.sign)
+
+			if err := it.IssuerSign().Verify(networkID, contract, updatedCredential(tc.prev, it)); err == nil {
+				t.Fatal("invalid issuer sign verified")
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateHolderDIDFactHint = hint.MustNewHint("mitum-credential-update-holder-did-operation-fact-v0.0.1")
+	UpdateHolderDIDHint     = hint.MustNewHint("mitum-credential-update-holder-did-operation-v0.0.1")
+)
+
+// UpdateHolderDIDFact changes the did of the sender, the holder, in the
+// credential service. The new did is used by the credentials assigned after;
+// the credentials already assigned keep the did they are assigned with, which
+// is signed by issuer, so their credentialSubject.id is still the old did. The
+// reverse index of the old did is not removed, because states are not
+// removed; it is treated as stale by the did checks of assignment.
+type UpdateHolderDIDFact struct {
+	base.BaseFact
+	sender   base.Address
+	contract base.Address
+	did      string
+	currency currencytypes.CurrencyID
+}
+
+func NewUpdateHolderDIDFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	did string,
+	currency currencytypes.CurrencyID,
+) UpdateHolderDIDFact {
+	bf := base.NewBaseFact(UpdateHolderDIDFactHint, token)
+	fact := UpdateHolderDIDFact{
+		BaseFact: bf,
+		sender:   sender,
+		contract: contract,
+		did:      did,
+		currency: currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateHolderDIDFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateHolderDIDFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateHolderDIDFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.did),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateHolderDIDFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		types.DID(fact.did),
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact UpdateHolderDIDFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateHolderDIDFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateHolderDIDFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateHolderDIDFact) DID() string {
+	return fact.did
+}
+
+func (fact UpdateHolderDIDFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateHolderDIDFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type UpdateHolderDID struct {
+	common.BaseOperation
+}
+
+func NewUpdateHolderDID(fact UpdateHolderDIDFact) (UpdateHolderDID, error) {
+	return UpdateHolderDID{BaseOperation: common.NewBaseOperation(UpdateHolderDIDHint, fact)}, nil
+}
@@ -0,0 +1,76 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateHolderDIDFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender   base.Address             `json:"sender"`
+	Contract base.Address             `json:"contract"`
+	DID      string                   `json:"did"`
+	Currency currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateHolderDIDFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateHolderDIDFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		DID:                   fact.did,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateHolderDIDFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender   string `json:"sender"`
+	Contract string `json:"contract"`
+	DID      string `json:"did"`
+	Currency string `json:"currency"`
+}
+
+func (fact *UpdateHolderDIDFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateHolderDIDFact")
+
+	var uf UpdateHolderDIDFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.DID,
+		uf.Currency,
+	)
+}
+
+type UpdateHolderDIDMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateHolderDID) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateHolderDIDMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateHolderDID) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateHolderDID")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var updateHolderDIDProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateHolderDIDProcessor)
+	},
+}
+
+func (UpdateHolderDID) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateHolderDIDProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateHolderDIDProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateHolderDIDProcessor")
+
+		nopp := updateHolderDIDProcessorPool.Get()
+		opp, ok := nopp.(*UpdateHolderDIDProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateHolderDIDProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateHolderDIDProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess UpdateHolderDID")
+
+	fact, ok := op.Fact().(UpdateHolderDIDFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", UpdateHolderDIDFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(state.StateKeyDesign(fact.Contract()), "key of design", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	design, err := state.StateDesignValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service value not found from state, %s; %w", fact.Contract(), err), nil
+	}
+
+	if m := types.DID(fact.DID()).Method(); !design.IsAllowedDIDMethod(m) {
+		return nil, base.NewBaseOperationProcessReasonError("did method not allowed by credential service, %q, %s", m, fact.Contract()), nil
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyHolderDID(fact.Contract(), fact.Sender()), "key of holder did", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender is not holder of credential service, %q, %s; %w", fact.Sender(), fact.Contract(), err), nil
+	}
+
+	switch did, err := state.StateHolderDIDValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("holder did value not found from state, %q, %s; %w", fact.Sender(), fact.Contract(), err), nil
+	case did == fact.DID():
+		return nil, base.NewBaseOperationProcessReasonError("holder already has did, %q, %q", fact.Sender(), did), nil
+	}
+
+	if err := checkDIDHolder(fact.Contract(), fact.Sender(), fact.DID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateHolderDIDProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(UpdateHolderDIDFact)
+
+	sts := make([]base.StateMergeValue, 3)
+
+	sts[0] = currencystate.NewStateMergeValue(
+		state.StateKeyHolderDID(fact.Contract(), fact.Sender()),
+		state.NewHolderDIDStateValue(fact.DID()),
+	)
+
+	sts[1] = currencystate.NewStateMergeValue(
+		state.StateKeyDIDHolder(fact.Contract(), fact.DID()),
+		state.NewDIDHolderStateValue(fact.Sender()),
+	)
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts[2] = currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))))
+
+	return sts, nil, nil
+}
+
+func (opp *UpdateHolderDIDProcessor) Close() error {
+	updateHolderDIDProcessorPool.Put(opp)
+
+	return nil
+}
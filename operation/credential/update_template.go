@@ -0,0 +1,271 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateTemplateFactHint = hint.MustNewHint("mitum-credential-update-template-operation-fact-v0.0.1")
+	UpdateTemplateHint     = hint.MustNewHint("mitum-credential-update-template-operation-v0.0.1")
+)
+
+type UpdateTemplateFact struct {
+	base.BaseFact
+	sender          base.Address
+	contract        base.Address
+	templateID      string
+	templateName    string
+	serviceDate     types.Date
+	expirationDate  types.Date
+	templateShare   types.Bool
+	multiAudit      types.Bool
+	consentRequired types.Bool
+	auditorSet      *types.AuditorSet
+	quota           *types.Quota
+	schema          *types.CredentialSchema
+	displayName     string
+	description     string
+	currency        currencytypes.CurrencyID
+}
+
+func NewUpdateTemplateFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	templateName string,
+	serviceDate types.Date,
+	expirationDate types.Date,
+	templateShare types.Bool,
+	multiAudit types.Bool,
+	consentRequired types.Bool,
+	auditorSet *types.AuditorSet,
+	quota *types.Quota,
+	schema *types.CredentialSchema,
+	displayName string,
+	description string,
+	currency currencytypes.CurrencyID,
+) UpdateTemplateFact {
+	bf := base.NewBaseFact(UpdateTemplateFactHint, token)
+	fact := UpdateTemplateFact{
+		BaseFact:        bf,
+		sender:          sender,
+		contract:        contract,
+		templateID:      templateID,
+		templateName:    templateName,
+		serviceDate:     serviceDate,
+		expirationDate:  expirationDate,
+		templateShare:   templateShare,
+		multiAudit:      multiAudit,
+		consentRequired: consentRequired,
+		auditorSet:      auditorSet,
+		quota:           quota,
+		schema:          schema,
+		displayName:     displayName,
+		description:     description,
+		currency:        currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateTemplateFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateTemplateFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateTemplateFact) Bytes() []byte {
+	var auditorSet []byte
+	if fact.auditorSet != nil {
+		auditorSet = fact.auditorSet.Bytes()
+	}
+
+	var quota []byte
+	if fact.quota != nil {
+		quota = fact.quota.Bytes()
+	}
+
+	var schema []byte
+	if fact.schema != nil {
+		schema = fact.schema.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		[]byte(fact.templateName),
+		fact.serviceDate.Bytes(),
+		fact.expirationDate.Bytes(),
+		fact.templateShare.Bytes(),
+		fact.multiAudit.Bytes(),
+		fact.consentRequired.FlagBytes(),
+		auditorSet,
+		quota,
+		schema,
+		[]byte(fact.displayName),
+		[]byte(fact.description),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateTemplateFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.serviceDate,
+		fact.expirationDate,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if l := utf8.RuneCountInString(fact.templateName); l < 1 || l > MaxLengthTemplateName {
+		return util.ErrInvalid.Errorf("invalid length of template name, 0 <= length <= %d", MaxLengthTemplateName)
+	}
+
+	if l := utf8.RuneCountInString(fact.displayName); l < 1 || l > MaxLengthDisplayName {
+		return util.ErrInvalid.Errorf("invalid length of display name, 0 <= length <= %d", MaxLengthDisplayName)
+	}
+
+	if l := utf8.RuneCountInString(fact.description); l < 1 || l > MaxLengthDescription {
+		return util.ErrInvalid.Errorf("invalid length of description, 0 <= length <= %d", MaxLengthDescription)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	serviceDate, err := fact.serviceDate.Parse()
+	if err != nil {
+		return err
+	}
+
+	expire, err := fact.expirationDate.Parse()
+	if err != nil {
+		return err
+	}
+
+	if expire.UnixNano() < serviceDate.UnixNano() {
+		return util.ErrInvalid.Errorf("expire date <= service date, %s <= %s", fact.expirationDate, fact.serviceDate)
+	}
+
+	if err := isValidAuditorSet(fact.multiAudit, fact.auditorSet); err != nil {
+		return err
+	}
+
+	if fact.quota != nil {
+		if err := fact.quota.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if fact.schema != nil {
+		if err := fact.schema.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact UpdateTemplateFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateTemplateFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateTemplateFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateTemplateFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact UpdateTemplateFact) TemplateName() string {
+	return fact.templateName
+}
+
+func (fact UpdateTemplateFact) ServiceDate() types.Date {
+	return fact.serviceDate
+}
+
+func (fact UpdateTemplateFact) ExpirationDate() types.Date {
+	return fact.expirationDate
+}
+
+func (fact UpdateTemplateFact) TemplateShare() types.Bool {
+	return fact.templateShare
+}
+
+func (fact UpdateTemplateFact) MultiAudit() types.Bool {
+	return fact.multiAudit
+}
+
+func (fact UpdateTemplateFact) ConsentRequired() types.Bool {
+	return fact.consentRequired
+}
+
+func (fact UpdateTemplateFact) AuditorSet() *types.AuditorSet {
+	return fact.auditorSet
+}
+
+func (fact UpdateTemplateFact) Quota() *types.Quota {
+	return fact.quota
+}
+
+func (fact UpdateTemplateFact) Schema() *types.CredentialSchema {
+	return fact.schema
+}
+
+func (fact UpdateTemplateFact) DisplayName() string {
+	return fact.displayName
+}
+
+func (fact UpdateTemplateFact) Description() string {
+	return fact.description
+}
+
+func (fact UpdateTemplateFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateTemplateFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type UpdateTemplate struct {
+	common.BaseOperation
+}
+
+func NewUpdateTemplate(fact UpdateTemplateFact) (UpdateTemplate, error) {
+	return UpdateTemplate{BaseOperation: common.NewBaseOperation(UpdateTemplateHint, fact)}, nil
+}
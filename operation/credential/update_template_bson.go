@@ -0,0 +1,153 @@
+package credential // nolint: dupl
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+func (fact UpdateTemplateFact) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":            fact.Hint().String(),
+		"sender":           fact.sender,
+		"contract":         fact.contract,
+		"template_id":      fact.templateID,
+		"template_name":    fact.templateName,
+		"service_date":     fact.serviceDate,
+		"expiration_date":  fact.expirationDate,
+		"template_share":   fact.templateShare,
+		"multi_audit":      fact.multiAudit,
+		"consent_required": fact.consentRequired,
+		"display_name":     fact.displayName,
+		"description":      fact.description,
+		"currency":         fact.currency,
+		"hash":             fact.BaseFact.Hash().String(),
+		"token":            fact.BaseFact.Token(),
+	}
+
+	if fact.auditorSet != nil {
+		m["auditor_set"] = fact.auditorSet
+	}
+
+	if fact.quota != nil {
+		m["quota"] = fact.quota
+	}
+
+	if fact.schema != nil {
+		m["schema"] = fact.schema
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type UpdateTemplateFactBSONUnmarshaler struct {
+	Hint            string   `bson:"_hint"`
+	Sender          string   `bson:"sender"`
+	Contract        string   `bson:"contract"`
+	TemplateID      string   `bson:"template_id"`
+	TemplateName    string   `bson:"template_name"`
+	ServiceDate     string   `bson:"service_date"`
+	ExpirationDate  string   `bson:"expiration_date"`
+	TemplateShare   bool     `bson:"template_share"`
+	MultiAudit      bool     `bson:"multi_audit"`
+	ConsentRequired bool     `bson:"consent_required"`
+	AuditorSet      bson.Raw `bson:"auditor_set,omitempty"`
+	Quota           bson.Raw `bson:"quota,omitempty"`
+	Schema          bson.Raw `bson:"schema,omitempty"`
+	DisplayName     string   `bson:"display_name"`
+	Description     string   `bson:"description"`
+	Currency        string   `bson:"currency"`
+}
+
+func (fact *UpdateTemplateFact) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of UpdateTemplateFact")
+
+	var ubf common.BaseFactBSONUnmarshaler
+
+	if err := enc.Unmarshal(b, &ubf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetHash(valuehash.NewBytesFromString(ubf.Hash))
+	fact.BaseFact.SetToken(ubf.Token)
+
+	var uf UpdateTemplateFactBSONUnmarshaler
+	if err := bson.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(uf.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	fact.BaseHinter = hint.NewBaseHinter(ht)
+
+	var auditorSet *types.AuditorSet
+	if len(uf.AuditorSet) > 0 {
+		auditorSet = new(types.AuditorSet)
+		if err := auditorSet.DecodeBSON(uf.AuditorSet, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var quota *types.Quota
+	if len(uf.Quota) > 0 {
+		quota = new(types.Quota)
+		if err := quota.DecodeBSON(uf.Quota, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var schema *types.CredentialSchema
+	if len(uf.Schema) > 0 {
+		schema = new(types.CredentialSchema)
+		if err := schema.DecodeBSON(uf.Schema, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.TemplateName,
+		uf.ServiceDate,
+		uf.ExpirationDate,
+		uf.TemplateShare,
+		uf.MultiAudit,
+		uf.ConsentRequired,
+		auditorSet,
+		quota,
+		schema,
+		uf.DisplayName,
+		uf.Description,
+		uf.Currency)
+}
+
+func (op UpdateTemplate) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": op.Hint().String(),
+			"hash":  op.Hash().String(),
+			"fact":  op.Fact(),
+			"signs": op.Signs(),
+		})
+}
+
+func (op *UpdateTemplate) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of UpdateTemplate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeBSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
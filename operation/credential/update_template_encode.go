@@ -0,0 +1,51 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+)
+
+func (fact *UpdateTemplateFact) unpack(enc encoder.Encoder,
+	sAdr, cAdr, tmplID string,
+	tmplName, svcDate, expDate string,
+	tmplShr, ma, cr bool,
+	auditorSet *types.AuditorSet,
+	quota *types.Quota,
+	schema *types.CredentialSchema,
+	dpName, desc, cid string,
+) error {
+	e := util.StringError("failed to unmarshal UpdateTemplateFact")
+
+	fact.templateID = tmplID
+	fact.templateName = tmplName
+	fact.serviceDate = types.Date(svcDate)
+	fact.expirationDate = types.Date(expDate)
+	fact.templateShare = types.Bool(tmplShr)
+	fact.multiAudit = types.Bool(ma)
+	fact.consentRequired = types.Bool(cr)
+	fact.auditorSet = auditorSet
+	fact.quota = quota
+	fact.schema = schema
+	fact.displayName = dpName
+	fact.description = desc
+	fact.currency = currencytypes.CurrencyID(cid)
+
+	switch a, err := base.DecodeAddress(sAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.sender = a
+	}
+
+	switch a, err := base.DecodeAddress(cAdr, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		fact.contract = a
+	}
+
+	return nil
+}
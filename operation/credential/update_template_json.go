@@ -0,0 +1,147 @@
+package credential
+
+import (
+	"encoding/json"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateTemplateFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender          base.Address             `json:"sender"`
+	Contract        base.Address             `json:"contract"`
+	TemplateID      string                   `json:"template_id"`
+	TemplateName    string                   `json:"template_name"`
+	ServiceDate     types.Date               `json:"service_date"`
+	ExpirationDate  types.Date               `json:"expiration_date"`
+	TemplateShare   types.Bool               `json:"template_share"`
+	MultiAudit      types.Bool               `json:"multi_audit"`
+	ConsentRequired types.Bool               `json:"consent_required"`
+	AuditorSet      *types.AuditorSet        `json:"auditor_set,omitempty"`
+	Quota           *types.Quota             `json:"quota,omitempty"`
+	Schema          *types.CredentialSchema  `json:"schema,omitempty"`
+	DisplayName     string                   `json:"display_name"`
+	Description     string                   `json:"description"`
+	Currency        currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateTemplateFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateTemplateFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		TemplateName:          fact.templateName,
+		ServiceDate:           fact.serviceDate,
+		ExpirationDate:        fact.expirationDate,
+		TemplateShare:         fact.templateShare,
+		MultiAudit:            fact.multiAudit,
+		ConsentRequired:       fact.consentRequired,
+		AuditorSet:            fact.auditorSet,
+		Quota:                 fact.quota,
+		Schema:                fact.schema,
+		DisplayName:           fact.displayName,
+		Description:           fact.description,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateTemplateFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender          string          `json:"sender"`
+	Contract        string          `json:"contract"`
+	TemplateID      string          `json:"template_id"`
+	TemplateName    string          `json:"template_name"`
+	ServiceDate     string          `json:"service_date"`
+	ExpirationDate  string          `json:"expiration_date"`
+	TemplateShare   bool            `json:"template_share"`
+	MultiAudit      bool            `json:"multi_audit"`
+	ConsentRequired bool            `json:"consent_required"`
+	AuditorSet      json.RawMessage `json:"auditor_set"`
+	Quota           json.RawMessage `json:"quota"`
+	Schema          json.RawMessage `json:"schema"`
+	DisplayName     string          `json:"display_name"`
+	Description     string          `json:"description"`
+	Currency        string          `json:"currency"`
+}
+
+func (fact *UpdateTemplateFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateTemplateFact")
+
+	var uf UpdateTemplateFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	var auditorSet *types.AuditorSet
+	if len(uf.AuditorSet) > 0 && string(uf.AuditorSet) != "null" {
+		auditorSet = new(types.AuditorSet)
+		if err := auditorSet.DecodeJSON(uf.AuditorSet, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var quota *types.Quota
+	if len(uf.Quota) > 0 && string(uf.Quota) != "null" {
+		quota = new(types.Quota)
+		if err := quota.DecodeJSON(uf.Quota, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var schema *types.CredentialSchema
+	if len(uf.Schema) > 0 && string(uf.Schema) != "null" {
+		schema = new(types.CredentialSchema)
+		if err := schema.DecodeJSON(uf.Schema, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.TemplateName,
+		uf.ServiceDate,
+		uf.ExpirationDate,
+		uf.TemplateShare,
+		uf.MultiAudit,
+		uf.ConsentRequired,
+		auditorSet,
+		quota,
+		schema,
+		uf.DisplayName,
+		uf.Description,
+		uf.Currency,
+	)
+}
+
+type UpdateTemplateMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateTemplate) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateTemplateMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateTemplate) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateTemplate")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package credential
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/valuehash"
+)
+
+var (
+	UpdateTemplateStatusFactHint = hint.MustNewHint("mitum-credential-update-template-status-operation-fact-v0.0.1")
+	UpdateTemplateStatusHint     = hint.MustNewHint("mitum-credential-update-template-status-operation-v0.0.1")
+)
+
+type UpdateTemplateStatusFact struct {
+	base.BaseFact
+	sender     base.Address
+	contract   base.Address
+	templateID string
+	status     types.TemplateStatus
+	currency   currencytypes.CurrencyID
+}
+
+func NewUpdateTemplateStatusFact(
+	token []byte,
+	sender base.Address,
+	contract base.Address,
+	templateID string,
+	status types.TemplateStatus,
+	currency currencytypes.CurrencyID,
+) UpdateTemplateStatusFact {
+	bf := base.NewBaseFact(UpdateTemplateStatusFactHint, token)
+	fact := UpdateTemplateStatusFact{
+		BaseFact:   bf,
+		sender:     sender,
+		contract:   contract,
+		templateID: templateID,
+		status:     status,
+		currency:   currency,
+	}
+	fact.SetHash(fact.GenerateHash())
+
+	return fact
+}
+
+func (fact UpdateTemplateStatusFact) Hash() util.Hash {
+	return fact.BaseFact.Hash()
+}
+
+func (fact UpdateTemplateStatusFact) GenerateHash() util.Hash {
+	return valuehash.NewSHA256(fact.Bytes())
+}
+
+func (fact UpdateTemplateStatusFact) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		fact.Token(),
+		fact.sender.Bytes(),
+		fact.contract.Bytes(),
+		[]byte(fact.templateID),
+		fact.status.Bytes(),
+		fact.currency.Bytes(),
+	)
+}
+
+func (fact UpdateTemplateStatusFact) IsValid(b []byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		fact.BaseHinter,
+		fact.sender,
+		fact.contract,
+		fact.status,
+		fact.currency,
+	); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(fact.templateID); l < 1 || l > MaxLengthTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthTemplateID)
+	}
+
+	if fact.sender.Equal(fact.contract) {
+		return util.ErrInvalid.Errorf("contract address is same with sender, %q", fact.sender)
+	}
+
+	if err := common.IsValidOperationFact(fact, b); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (fact UpdateTemplateStatusFact) Token() base.Token {
+	return fact.BaseFact.Token()
+}
+
+func (fact UpdateTemplateStatusFact) Sender() base.Address {
+	return fact.sender
+}
+
+func (fact UpdateTemplateStatusFact) Contract() base.Address {
+	return fact.contract
+}
+
+func (fact UpdateTemplateStatusFact) TemplateID() string {
+	return fact.templateID
+}
+
+func (fact UpdateTemplateStatusFact) Status() types.TemplateStatus {
+	return fact.status
+}
+
+func (fact UpdateTemplateStatusFact) Currency() currencytypes.CurrencyID {
+	return fact.currency
+}
+
+func (fact UpdateTemplateStatusFact) Addresses() ([]base.Address, error) {
+	as := make([]base.Address, 2)
+	as[0] = fact.sender
+	as[1] = fact.contract
+	return as, nil
+}
+
+type UpdateTemplateStatus struct {
+	common.BaseOperation
+}
+
+func NewUpdateTemplateStatus(fact UpdateTemplateStatusFact) (UpdateTemplateStatus, error) {
+	return UpdateTemplateStatus{BaseOperation: common.NewBaseOperation(UpdateTemplateStatusHint, fact)}, nil
+}
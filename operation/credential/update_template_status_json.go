@@ -0,0 +1,81 @@
+package credential
+
+import (
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+)
+
+type UpdateTemplateStatusFactJSONMarshaler struct {
+	base.BaseFactJSONMarshaler
+	Sender     base.Address             `json:"sender"`
+	Contract   base.Address             `json:"contract"`
+	TemplateID string                   `json:"template_id"`
+	Status     types.TemplateStatus     `json:"status"`
+	Currency   currencytypes.CurrencyID `json:"currency"`
+}
+
+func (fact UpdateTemplateStatusFact) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateTemplateStatusFactJSONMarshaler{
+		BaseFactJSONMarshaler: fact.BaseFact.JSONMarshaler(),
+		Sender:                fact.sender,
+		Contract:              fact.contract,
+		TemplateID:            fact.templateID,
+		Status:                fact.status,
+		Currency:              fact.currency,
+	})
+}
+
+type UpdateTemplateStatusFactJSONUnMarshaler struct {
+	base.BaseFactJSONUnmarshaler
+	Sender     string `json:"sender"`
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	Status     string `json:"status"`
+	Currency   string `json:"currency"`
+}
+
+func (fact *UpdateTemplateStatusFact) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateTemplateStatusFact")
+
+	var uf UpdateTemplateStatusFactJSONUnMarshaler
+	if err := enc.Unmarshal(b, &uf); err != nil {
+		return e.Wrap(err)
+	}
+
+	fact.BaseFact.SetJSONUnmarshaler(uf.BaseFactJSONUnmarshaler)
+
+	return fact.unpack(enc,
+		uf.Sender,
+		uf.Contract,
+		uf.TemplateID,
+		uf.Status,
+		uf.Currency,
+	)
+}
+
+type UpdateTemplateStatusMarshaler struct {
+	common.BaseOperationJSONMarshaler
+}
+
+func (op UpdateTemplateStatus) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(UpdateTemplateStatusMarshaler{
+		BaseOperationJSONMarshaler: op.BaseOperation.JSONMarshaler(),
+	})
+}
+
+func (op *UpdateTemplateStatus) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of UpdateTemplateStatus")
+
+	var ubo common.BaseOperation
+	if err := ubo.DecodeJSON(b, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	op.BaseOperation = ubo
+
+	return nil
+}
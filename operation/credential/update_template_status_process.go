@@ -0,0 +1,188 @@
+package credential
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ProtoconNet/mitum-credential/state"
+	"github.com/ProtoconNet/mitum-credential/types"
+	"github.com/ProtoconNet/mitum-currency/v3/common"
+	currencystate "github.com/ProtoconNet/mitum-currency/v3/state"
+	"github.com/ProtoconNet/mitum-currency/v3/state/currency"
+	extensioncurrency "github.com/ProtoconNet/mitum-currency/v3/state/extension"
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+var updateTemplateStatusProcessorPool = sync.Pool{
+	New: func() interface{} {
+		return new(UpdateTemplateStatusProcessor)
+	},
+}
+
+func (UpdateTemplateStatus) Process(
+	_ context.Context, _ base.GetStateFunc,
+) ([]base.StateMergeValue, base.OperationProcessReasonError, error) {
+	return nil, nil, nil
+}
+
+type UpdateTemplateStatusProcessor struct {
+	*base.BaseOperationProcessor
+}
+
+func NewUpdateTemplateStatusProcessor() currencytypes.GetNewProcessor {
+	return func(
+		height base.Height,
+		getStateFunc base.GetStateFunc,
+		newPreProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+		newProcessConstraintFunc base.NewOperationProcessorProcessFunc,
+	) (base.OperationProcessor, error) {
+		e := util.StringError("failed to create new UpdateTemplateStatusProcessor")
+
+		nopp := updateTemplateStatusProcessorPool.Get()
+		opp, ok := nopp.(*UpdateTemplateStatusProcessor)
+		if !ok {
+			return nil, errors.Errorf("expected UpdateTemplateStatusProcessor, not %T", nopp)
+		}
+
+		b, err := base.NewBaseOperationProcessor(
+			height, getStateFunc, newPreProcessConstraintFunc, newProcessConstraintFunc)
+		if err != nil {
+			return nil, e.Wrap(err)
+		}
+
+		opp.BaseOperationProcessor = b
+
+		return opp, nil
+	}
+}
+
+func (opp *UpdateTemplateStatusProcessor) PreProcess(
+	ctx context.Context, op base.Operation, getStateFunc base.GetStateFunc,
+) (context.Context, base.OperationProcessReasonError, error) {
+	e := util.StringError("failed to preprocess UpdateTemplateStatus")
+
+	fact, ok := op.Fact().(UpdateTemplateStatusFact)
+	if !ok {
+		return ctx, nil, e.Errorf("not %T, %T", UpdateTemplateStatusFact{}, op.Fact())
+	}
+
+	if err := fact.IsValid(nil); err != nil {
+		return ctx, nil, e.Wrap(err)
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account state not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	if err := currencystate.CheckExistsState(currency.StateKeyCurrencyDesign(fact.Currency()), getStateFunc); err != nil {
+		return ctx, nil, e.WithMessage(err, "fee Currency state not found")
+	}
+
+	if err := currencystate.CheckNotExistsState(extensioncurrency.StateKeyContractAccount(fact.Sender()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender account is contract account, %q; %w", fact.Sender(), err), nil
+	}
+
+	st, err := currencystate.ExistsState(extensioncurrency.StateKeyContractAccount(fact.Contract()), "key of contract account", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("target contract account state not found, %q; %w", fact.Contract(), err), nil
+	}
+
+	ca, err := extensioncurrency.StateContractAccountValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("contract account value not found from state, %q; %w", fact.Contract(), err), nil
+	}
+
+	if err := checkServiceRole(ca, fact.Contract(), fact.Sender(), types.RoleTemplateAdmin, fact.TemplateID(), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("%w", err), nil
+	}
+
+	if err := currencystate.CheckExistsState(state.StateKeyDesign(fact.Contract()), getStateFunc); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("credential service state not found, %s; %w", fact.Contract(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(state.StateKeyTemplate(fact.Contract(), fact.TemplateID()), "key of template", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template not found, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	if _, err := state.StateTemplateValue(st); err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template value not found from state, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	switch status, err := state.StateTemplateStatusValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("template status not found from state, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	case status == types.TemplateStatusRemoved:
+		return nil, base.NewBaseOperationProcessReasonError("template already removed, %q, %s", fact.TemplateID(), fact.Contract()), nil
+	case status == fact.Status():
+		return nil, base.NewBaseOperationProcessReasonError("template already %s, %q, %s", status, fact.TemplateID(), fact.Contract()), nil
+	}
+
+	if err := currencystate.CheckFactSignsByState(fact.Sender(), op.Signs(), getStateFunc); err != nil {
+		return ctx, base.NewBaseOperationProcessReasonError("invalid signing; %w", err), nil
+	}
+
+	return ctx, nil, nil
+}
+
+func (opp *UpdateTemplateStatusProcessor) Process(
+	_ context.Context, op base.Operation, getStateFunc base.GetStateFunc) (
+	[]base.StateMergeValue, base.OperationProcessReasonError, error,
+) {
+	fact, _ := op.Fact().(UpdateTemplateStatusFact)
+
+	st, err := currencystate.ExistsState(state.StateKeyTemplate(fact.Contract(), fact.TemplateID()), "key of template", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template not found, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	template, err := state.StateTemplateValue(st)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("template value not found from state, %q, %s; %w", fact.TemplateID(), fact.Contract(), err), nil
+	}
+
+	sts := make([]base.StateMergeValue, 2)
+
+	sts[0] = currencystate.NewStateMergeValue(
+		state.StateKeyTemplate(fact.Contract(), fact.TemplateID()),
+		state.NewTemplateStateValue(template, fact.Status()),
+	)
+
+	currencyPolicy, _ := currencystate.ExistsCurrencyPolicy(fact.Currency(), getStateFunc)
+
+	fee, err := currencyPolicy.Feeer().Fee(common.ZeroBig)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("failed to check fee of currency, %q; %w", fact.Currency(), err), nil
+	}
+
+	st, err = currencystate.ExistsState(currency.StateKeyBalance(fact.Sender(), fact.Currency()), "key of sender balance", getStateFunc)
+	if err != nil {
+		return nil, base.NewBaseOperationProcessReasonError("sender balance not found, %q; %w", fact.Sender(), err), nil
+	}
+
+	sb := currencystate.NewStateMergeValue(st.Key(), st.Value())
+
+	switch b, err := currency.StateBalanceValue(st); {
+	case err != nil:
+		return nil, base.NewBaseOperationProcessReasonError("failed to get balance value, %q; %w", currency.StateKeyBalance(fact.Sender(), fact.Currency()), err), nil
+	case b.Big().Compare(fee) < 0:
+		return nil, base.NewBaseOperationProcessReasonError("not enough balance of sender, %q", fact.Sender()), nil
+	}
+
+	v, ok := sb.Value().(currency.BalanceStateValue)
+	if !ok {
+		return nil, base.NewBaseOperationProcessReasonError("expected BalanceStateValue, not %T", sb.Value()), nil
+	}
+	sts[1] = currencystate.NewStateMergeValue(sb.Key(), currency.NewBalanceStateValue(v.Amount.WithBig(v.Amount.Big().Sub(fee))))
+
+	return sts, nil, nil
+}
+
+func (opp *UpdateTemplateStatusProcessor) Close() error {
+	updateTemplateStatusProcessorPool.Put(opp)
+
+	return nil
+}
@@ -16,6 +16,7 @@ const (
 	DuplicationTypeCurrency   currencytypes.DuplicationType = "currency"
 	DuplicationTypeContract   currencytypes.DuplicationType = "contract"
 	DuplicationTypeCredential currencytypes.DuplicationType = "credential"
+	DuplicationTypeHolderDID  currencytypes.DuplicationType = "holder-did"
 )
 
 func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operation) error {
@@ -26,6 +27,7 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 	var duplicationTypeCurrencyID string
 	var duplicationTypeCredentialID []string
 	var duplicationTypeContract string
+	var duplicationTypeHolderDID []string
 	var newAddresses []base.Address
 
 	switch t := op.(type) {
@@ -95,6 +97,48 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 			return errors.Errorf("expected AddTemplateFact, not %T", t.Fact())
 		}
 		duplicationTypeSenderID = fact.Sender().String()
+	case credential.UpdateTemplate:
+		fact, ok := t.Fact().(credential.UpdateTemplateFact)
+		if !ok {
+			return errors.Errorf("expected UpdateTemplateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+	case credential.UpdateTemplateStatus:
+		fact, ok := t.Fact().(credential.UpdateTemplateStatusFact)
+		if !ok {
+			return errors.Errorf("expected UpdateTemplateStatusFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+	case credential.GrantTemplate:
+		fact, ok := t.Fact().(credential.GrantTemplateFact)
+		if !ok {
+			return errors.Errorf("expected GrantTemplateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+	case credential.GrantRole:
+		fact, ok := t.Fact().(credential.GrantRoleFact)
+		if !ok {
+			return errors.Errorf("expected GrantRoleFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+	case credential.RevokeRole:
+		fact, ok := t.Fact().(credential.RevokeRoleFact)
+		if !ok {
+			return errors.Errorf("expected RevokeRoleFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+	case credential.AnchorBatch:
+		fact, ok := t.Fact().(credential.AnchorBatchFact)
+		if !ok {
+			return errors.Errorf("expected AnchorBatchFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+	case credential.RevokeBatch:
+		fact, ok := t.Fact().(credential.RevokeBatchFact)
+		if !ok {
+			return errors.Errorf("expected RevokeBatchFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
 	case credential.Assign:
 		fact, ok := t.Fact().(credential.AssignFact)
 		if !ok {
@@ -102,10 +146,39 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 		}
 		duplicationTypeSenderID = fact.Sender().String()
 		var credentials []string
+		holderDIDs := map[string]struct{}{}
 		for _, v := range fact.Items() {
 			credentials = append(credentials, fmt.Sprintf("%s-%s-%s", v.Contract().String(), v.TemplateID(), v.ID()))
+
+			for _, k := range holderDIDDuplicationKeys(v.Contract(), v.Holder(), v.DID()) {
+				if _, found := holderDIDs[k]; !found {
+					holderDIDs[k] = struct{}{}
+					duplicationTypeHolderDID = append(duplicationTypeHolderDID, k)
+				}
+			}
 		}
 		duplicationTypeCredentialID = credentials
+	case credential.UpdateHolderDID:
+		fact, ok := t.Fact().(credential.UpdateHolderDIDFact)
+		if !ok {
+			return errors.Errorf("expected UpdateHolderDIDFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+		duplicationTypeHolderDID = holderDIDDuplicationKeys(fact.Contract(), fact.Sender(), fact.DID())
+	case credential.ApproveAssignment:
+		fact, ok := t.Fact().(credential.ApproveAssignmentFact)
+		if !ok {
+			return errors.Errorf("expected ApproveAssignmentFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+		duplicationTypeCredentialID = []string{fmt.Sprintf("%s-%s-%s", fact.Contract().String(), fact.TemplateID(), fact.ID())}
+	case credential.Renounce:
+		fact, ok := t.Fact().(credential.RenounceFact)
+		if !ok {
+			return errors.Errorf("expected RenounceFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+		duplicationTypeCredentialID = []string{fmt.Sprintf("%s-%s-%s", fact.Contract().String(), fact.TemplateID(), fact.ID())}
 	case credential.Revoke:
 		fact, ok := t.Fact().(credential.RevokeFact)
 		if !ok {
@@ -117,6 +190,39 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 			credentials = append(credentials, fmt.Sprintf("%s-%s-%s", v.Contract().String(), v.TemplateID(), v.ID()))
 		}
 		duplicationTypeCredentialID = credentials
+	case credential.Suspend:
+		fact, ok := t.Fact().(credential.SuspendFact)
+		if !ok {
+			return errors.Errorf("expected SuspendFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+		var credentials []string
+		for _, v := range fact.Items() {
+			credentials = append(credentials, fmt.Sprintf("%s-%s-%s", v.Contract().String(), v.TemplateID(), v.ID()))
+		}
+		duplicationTypeCredentialID = credentials
+	case credential.Reinstate:
+		fact, ok := t.Fact().(credential.ReinstateFact)
+		if !ok {
+			return errors.Errorf("expected ReinstateFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+		var credentials []string
+		for _, v := range fact.Items() {
+			credentials = append(credentials, fmt.Sprintf("%s-%s-%s", v.Contract().String(), v.TemplateID(), v.ID()))
+		}
+		duplicationTypeCredentialID = credentials
+	case credential.UpdateCredential:
+		fact, ok := t.Fact().(credential.UpdateCredentialFact)
+		if !ok {
+			return errors.Errorf("expected UpdateCredentialFact, not %T", t.Fact())
+		}
+		duplicationTypeSenderID = fact.Sender().String()
+		var credentials []string
+		for _, v := range fact.Items() {
+			credentials = append(credentials, fmt.Sprintf("%s-%s-%s", v.Contract().String(), v.TemplateID(), v.ID()))
+		}
+		duplicationTypeCredentialID = credentials
 	default:
 		return nil
 	}
@@ -160,6 +266,18 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 		}
 	}
 
+	if len(duplicationTypeHolderDID) > 0 {
+		for _, v := range duplicationTypeHolderDID {
+			if _, found := opr.Duplicated[v]; found {
+				return errors.Errorf(
+					"cannot bind a duplicated holder or did for credential model, %v within a proposal",
+					v,
+				)
+			}
+			opr.Duplicated[v] = DuplicationTypeHolderDID
+		}
+	}
+
 	if len(newAddresses) > 0 {
 		if err := opr.CheckNewAddressDuplication(newAddresses); err != nil {
 			return err
@@ -169,6 +287,16 @@ func CheckDuplication(opr *currencyprocessor.OperationProcessor, op base.Operati
 	return nil
 }
 
+// holderDIDDuplicationKeys returns the keys to keep a holder and a did from
+// being bound by more than one operation in a proposal; the holder did
+// conflicts are checked against the state before the proposal.
+func holderDIDDuplicationKeys(contract, holder base.Address, did string) []string {
+	return []string{
+		fmt.Sprintf("%s-%s-holder-did", contract.String(), holder.String()),
+		fmt.Sprintf("%s-%s-did-holder", contract.String(), did),
+	}
+}
+
 func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op base.Operation) (base.OperationProcessor, bool, error) {
 	switch i, err := opr.GetNewProcessorFromHintset(op); {
 	case err != nil:
@@ -189,7 +317,20 @@ func GetNewProcessor(opr *currencyprocessor.OperationProcessor, op base.Operatio
 		credential.CreateService,
 		credential.AddTemplate,
 		credential.Assign,
-		credential.Revoke:
+		credential.Revoke,
+		credential.UpdateTemplate,
+		credential.UpdateTemplateStatus,
+		credential.UpdateCredential,
+		credential.Suspend,
+		credential.Reinstate,
+		credential.UpdateHolderDID,
+		credential.Renounce,
+		credential.ApproveAssignment,
+		credential.GrantTemplate,
+		credential.GrantRole,
+		credential.RevokeRole,
+		credential.AnchorBatch,
+		credential.RevokeBatch:
 		return nil, false, errors.Errorf("%T needs SetProcessor", t)
 	default:
 		return nil, false, nil
@@ -7,6 +7,7 @@ import (
 
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"github.com/pkg/errors"
 )
@@ -85,12 +86,14 @@ var (
 type TemplateStateValue struct {
 	hint.BaseHinter
 	Template types.Template
+	Status   types.TemplateStatus
 }
 
-func NewTemplateStateValue(template types.Template) TemplateStateValue {
+func NewTemplateStateValue(template types.Template, status types.TemplateStatus) TemplateStateValue {
 	return TemplateStateValue{
 		BaseHinter: hint.NewBaseHinter(TemplateStateValueHint),
 		Template:   template,
+		Status:     status,
 	}
 }
 
@@ -105,15 +108,24 @@ func (sv TemplateStateValue) IsValid([]byte) error {
 		return e.Wrap(err)
 	}
 
-	if err := sv.Template.IsValid(nil); err != nil {
+	if err := util.CheckIsValiders(nil, false,
+		sv.Template,
+		sv.Status,
+	); err != nil {
 		return e.Wrap(err)
 	}
 
 	return nil
 }
 
+// HashBytes omits the default active status, so the hashes of the template
+// states written before the status do not change.
 func (sv TemplateStateValue) HashBytes() []byte {
-	return sv.Template.Bytes()
+	if sv.Status == types.TemplateStatusActive {
+		return sv.Template.Bytes()
+	}
+
+	return util.ConcatBytesSlice(sv.Template.Bytes(), sv.Status.Bytes())
 }
 
 func StateKeyTemplate(contract base.Address, templateID string) string {
@@ -142,6 +154,20 @@ func StateTemplateValue(st base.State) (types.Template, error) {
 	return t.Template, nil
 }
 
+func StateTemplateStatusValue(st base.State) (types.TemplateStatus, error) {
+	v := st.Value()
+	if v == nil {
+		return "", util.ErrNotFound.Errorf("template not found in State")
+	}
+
+	t, ok := v.(TemplateStateValue)
+	if !ok {
+		return "", errors.Errorf("invalid template value found, %T", v)
+	}
+
+	return t.Status, nil
+}
+
 var (
 	CredentialStateValueHint = hint.MustNewHint("mitum-credential-credential-state-value-v0.0.1")
 	CredentialSuffix         = ":credential"
@@ -150,17 +176,32 @@ var (
 type CredentialStateValue struct {
 	hint.BaseHinter
 	Credential types.Credential
-	IsActive   bool
+	Status     types.CredentialStatus
+	Version    uint64
+	// Revocation is set for revoked credentials and, with the holder as
+	// revoker, for renounced ones.
+	Revocation *types.Revocation
+	// StatusIndex is the index of the credential in the status list of the
+	// template; nil for credentials assigned before status lists.
+	StatusIndex *uint64
+	// IssuerSign is the signature of issuer over the credential; nil when
+	// the credential is assigned or updated without signature.
+	IssuerSign *types.IssuerSign
 }
 
-func NewCredentialStateValue(credential types.Credential, isActive bool) CredentialStateValue {
+func NewCredentialStateValue(credential types.Credential, status types.CredentialStatus, version uint64) CredentialStateValue {
 	return CredentialStateValue{
 		BaseHinter: hint.NewBaseHinter(CredentialStateValueHint),
 		Credential: credential,
-		IsActive:   isActive,
+		Status:     status,
+		Version:    version,
 	}
 }
 
+func (sv CredentialStateValue) IsActive() bool {
+	return sv.Status == types.CredentialStatusActive
+}
+
 func (sv CredentialStateValue) Hint() hint.Hint {
 	return sv.BaseHinter.Hint()
 }
@@ -172,19 +213,81 @@ func (sv CredentialStateValue) IsValid([]byte) error {
 		return e.Wrap(err)
 	}
 
-	if err := sv.Credential.IsValid(nil); err != nil {
+	if err := util.CheckIsValiders(nil, false,
+		sv.Credential,
+		sv.Status,
+	); err != nil {
 		return e.Wrap(err)
 	}
 
+	if sv.Revocation != nil {
+		if !sv.Status.IsWithdrawn() {
+			return e.Wrap(errors.Errorf("revocation found in %s credential", sv.Status))
+		}
+
+		if err := sv.Revocation.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	if sv.IssuerSign != nil {
+		if err := sv.IssuerSign.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return nil
 }
 
+// HashBytes keeps the legacy layout, the active byte and the credential, for
+// the values which the legacy layout can express, so the hashes of the
+// credential states written before the status do not change.
 func (sv CredentialStateValue) HashBytes() []byte {
-	var v int8
-	if sv.IsActive {
-		v = 1
+	var active byte
+	if sv.IsActive() {
+		active = 1
+	}
+
+	legacy := util.ConcatBytesSlice([]byte{active}, sv.Credential.Bytes())
+
+	if sv.isLegacy() {
+		return legacy
+	}
+
+	var revocation, statusIndex, issuerSign []byte
+	if sv.Revocation != nil {
+		revocation = sv.Revocation.Bytes()
+	}
+	if sv.StatusIndex != nil {
+		statusIndex = util.Uint64ToBytes(*sv.StatusIndex)
+	}
+	if sv.IssuerSign != nil {
+		issuerSign = sv.IssuerSign.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		legacy,
+		sv.Status.Bytes(),
+		util.Uint64ToBytes(sv.Version),
+		revocation,
+		statusIndex,
+		issuerSign,
+	)
+}
+
+// isLegacy reports whether the value has only the fields of the credential
+// state before the status; active or revoked, without the others.
+func (sv CredentialStateValue) isLegacy() bool {
+	switch {
+	case sv.Status != types.CredentialStatusActive && sv.Status != types.CredentialStatusRevoked,
+		sv.Version > 0,
+		sv.Revocation != nil,
+		sv.StatusIndex != nil,
+		sv.IssuerSign != nil:
+		return false
+	default:
+		return true
 	}
-	return util.ConcatBytesSlice([]byte{byte(v)}, sv.Credential.Bytes())
 }
 
 func StateKeyCredential(contract base.Address, templateID string, id string) string {
@@ -211,7 +314,63 @@ func StateCredentialValue(st base.State) (types.Credential, bool, error) {
 		return types.Credential{}, false, errors.Errorf("invalid credential value found, %T", v)
 	}
 
-	return c.Credential, c.IsActive, nil
+	return c.Credential, c.IsActive(), nil
+}
+
+func StateCredentialStateValue(st base.State) (CredentialStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return CredentialStateValue{}, util.ErrNotFound.Errorf("credential not found in State")
+	}
+
+	c, ok := v.(CredentialStateValue)
+	if !ok {
+		return CredentialStateValue{}, errors.Errorf("invalid credential value found, %T", v)
+	}
+
+	return c, nil
+}
+
+func StateCredentialRevocationValue(st base.State) (*types.Revocation, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("credential not found in State")
+	}
+
+	c, ok := v.(CredentialStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid credential value found, %T", v)
+	}
+
+	return c.Revocation, nil
+}
+
+func StateCredentialStatusValue(st base.State) (types.CredentialStatus, error) {
+	v := st.Value()
+	if v == nil {
+		return "", util.ErrNotFound.Errorf("credential not found in State")
+	}
+
+	c, ok := v.(CredentialStateValue)
+	if !ok {
+		return "", errors.Errorf("invalid credential value found, %T", v)
+	}
+
+	return c.Status, nil
+}
+
+func StateCredentialVersionValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("credential not found in State")
+	}
+
+	c, ok := v.(CredentialStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid credential value found, %T", v)
+	}
+
+	return c.Version, nil
 }
 
 var (
@@ -275,6 +434,699 @@ func StateKeyHolderDID(contract base.Address, holder base.Address) string {
 	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(contract), holder.String(), HolderDIDSuffix)
 }
 
+var (
+	DIDHolderStateValueHint = hint.MustNewHint("mitum-credential-did-holder-state-value-v0.0.1")
+	DIDHolderSuffix         = ":did-holder"
+)
+
+// DIDHolderStateValue is the reverse index of HolderDIDStateValue. It is not
+// removed when the holder changes its did, so the holder is the owner of the
+// did only while its HolderDIDStateValue still has the did.
+type DIDHolderStateValue struct {
+	hint.BaseHinter
+	holder base.Address
+}
+
+func NewDIDHolderStateValue(holder base.Address) DIDHolderStateValue {
+	return DIDHolderStateValue{
+		BaseHinter: hint.NewBaseHinter(DIDHolderStateValueHint),
+		holder:     holder,
+	}
+}
+
+func (dh DIDHolderStateValue) Hint() hint.Hint {
+	return dh.BaseHinter.Hint()
+}
+
+func (dh DIDHolderStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential DIDHolderStateValue")
+
+	if err := dh.BaseHinter.IsValid(DIDHolderStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, dh.holder); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (dh DIDHolderStateValue) HashBytes() []byte {
+	return dh.holder.Bytes()
+}
+
+func StateDIDHolderValue(st base.State) (base.Address, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("did holder not found in State")
+	}
+
+	dh, ok := v.(DIDHolderStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid did holder value found, %T", v)
+	}
+
+	return dh.holder, nil
+}
+
+func IsStateDIDHolderKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, DIDHolderSuffix)
+}
+
+func StateKeyDIDHolder(contract base.Address, did string) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(contract), did, DIDHolderSuffix)
+}
+
+var (
+	HolderStatStateValueHint = hint.MustNewHint("mitum-credential-holder-stat-state-value-v0.0.1")
+	HolderStatSuffix         = ":holder-stat"
+)
+
+type HolderStatStateValue struct {
+	hint.BaseHinter
+	credentialCount uint64
+}
+
+func NewHolderStatStateValue(credentialCount uint64) HolderStatStateValue {
+	return HolderStatStateValue{
+		BaseHinter:      hint.NewBaseHinter(HolderStatStateValueHint),
+		credentialCount: credentialCount,
+	}
+}
+
+func (hs HolderStatStateValue) Hint() hint.Hint {
+	return hs.BaseHinter.Hint()
+}
+
+func (hs HolderStatStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential HolderStatStateValue")
+
+	if err := hs.BaseHinter.IsValid(HolderStatStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (hs HolderStatStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(hs.credentialCount)
+}
+
+func StateHolderStatValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("holder stat not found in State")
+	}
+
+	hs, ok := v.(HolderStatStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid holder stat value found, %T", v)
+	}
+
+	return hs.credentialCount, nil
+}
+
+func IsStateHolderStatKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, HolderStatSuffix)
+}
+
+func StateKeyHolderStat(contract base.Address, holder base.Address) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(contract), holder.String(), HolderStatSuffix)
+}
+
+func StateKeyTemplateHolderStat(contract base.Address, templateID string, holder base.Address) string {
+	return fmt.Sprintf("%s:%s:%s%s", StateKeyCredentialPrefix(contract), templateID, holder.String(), TemplateHolderStatSuffix)
+}
+
+var (
+	TemplateStatStateValueHint = hint.MustNewHint("mitum-credential-template-stat-state-value-v0.0.1")
+	TemplateStatSuffix         = ":template-stat"
+	TemplateHolderStatSuffix   = ":template-holder-stat"
+)
+
+// TemplateStatStateValue counts the credentials of the template issued by the
+// credential service; the revoked and renounced credentials are still
+// counted, so that the max supply of quota caps the total issuance.
+type TemplateStatStateValue struct {
+	hint.BaseHinter
+	credentialCount uint64
+}
+
+func NewTemplateStatStateValue(credentialCount uint64) TemplateStatStateValue {
+	return TemplateStatStateValue{
+		BaseHinter:      hint.NewBaseHinter(TemplateStatStateValueHint),
+		credentialCount: credentialCount,
+	}
+}
+
+func (ts TemplateStatStateValue) Hint() hint.Hint {
+	return ts.BaseHinter.Hint()
+}
+
+func (ts TemplateStatStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential TemplateStatStateValue")
+
+	if err := ts.BaseHinter.IsValid(TemplateStatStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ts TemplateStatStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(ts.credentialCount)
+}
+
+func StateTemplateStatValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("template stat not found in State")
+	}
+
+	ts, ok := v.(TemplateStatStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid template stat value found, %T", v)
+	}
+
+	return ts.credentialCount, nil
+}
+
+func IsStateTemplateStatKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, TemplateStatSuffix)
+}
+
+func StateKeyTemplateStat(contract base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(contract), templateID, TemplateStatSuffix)
+}
+
+var (
+	StatusListStateValueHint     = hint.MustNewHint("mitum-credential-status-list-state-value-v0.0.1")
+	StatusListSuffix             = ":status-list"
+	StatusListSizeStateValueHint = hint.MustNewHint("mitum-credential-status-list-size-state-value-v0.0.1")
+	StatusListSizeSuffix         = ":status-list-size"
+)
+
+// StatusListStateValue is a chunk of the status list of a template; the chunk
+// of index i holds the bits from i*types.StatusListChunkBits.
+type StatusListStateValue struct {
+	hint.BaseHinter
+	StatusList types.StatusList
+}
+
+func NewStatusListStateValue(statusList types.StatusList) StatusListStateValue {
+	return StatusListStateValue{
+		BaseHinter: hint.NewBaseHinter(StatusListStateValueHint),
+		StatusList: statusList,
+	}
+}
+
+func (sl StatusListStateValue) Hint() hint.Hint {
+	return sl.BaseHinter.Hint()
+}
+
+func (sl StatusListStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StatusListStateValue")
+
+	if err := sl.BaseHinter.IsValid(StatusListStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := sl.StatusList.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	if sl.StatusList.Size() > types.StatusListChunkBits {
+		return e.Wrap(errors.Errorf("size of status list chunk over %d, %d", types.StatusListChunkBits, sl.StatusList.Size()))
+	}
+
+	return nil
+}
+
+func (sl StatusListStateValue) HashBytes() []byte {
+	return sl.StatusList.Bytes()
+}
+
+func StateStatusListValue(st base.State) (types.StatusList, error) {
+	v := st.Value()
+	if v == nil {
+		return types.StatusList{}, util.ErrNotFound.Errorf("status list not found in State")
+	}
+
+	sl, ok := v.(StatusListStateValue)
+	if !ok {
+		return types.StatusList{}, errors.Errorf("invalid status list value found, %T", v)
+	}
+
+	return sl.StatusList, nil
+}
+
+func IsStateStatusListKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, StatusListSuffix)
+}
+
+func StateKeyStatusList(contract base.Address, templateID string, chunk uint64) string {
+	return fmt.Sprintf("%s:%s:%d%s", StateKeyCredentialPrefix(contract), templateID, chunk, StatusListSuffix)
+}
+
+// StatusListSizeStateValue is the number of the indexes allocated in the
+// status list of a template.
+type StatusListSizeStateValue struct {
+	hint.BaseHinter
+	size uint64
+}
+
+func NewStatusListSizeStateValue(size uint64) StatusListSizeStateValue {
+	return StatusListSizeStateValue{
+		BaseHinter: hint.NewBaseHinter(StatusListSizeStateValueHint),
+		size:       size,
+	}
+}
+
+func (ss StatusListSizeStateValue) Hint() hint.Hint {
+	return ss.BaseHinter.Hint()
+}
+
+func (ss StatusListSizeStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid StatusListSizeStateValue")
+
+	if err := ss.BaseHinter.IsValid(StatusListSizeStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ss StatusListSizeStateValue) HashBytes() []byte {
+	return util.Uint64ToBytes(ss.size)
+}
+
+func StateStatusListSizeValue(st base.State) (uint64, error) {
+	v := st.Value()
+	if v == nil {
+		return 0, util.ErrNotFound.Errorf("status list size not found in State")
+	}
+
+	ss, ok := v.(StatusListSizeStateValue)
+	if !ok {
+		return 0, errors.Errorf("invalid status list size value found, %T", v)
+	}
+
+	return ss.size, nil
+}
+
+func IsStateStatusListSizeKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, StatusListSizeSuffix)
+}
+
+func StateKeyStatusListSize(contract base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(contract), templateID, StatusListSizeSuffix)
+}
+
+var (
+	PendingAssignmentStateValueHint = hint.MustNewHint("mitum-credential-pending-assignment-state-value-v0.0.1")
+	PendingAssignmentSuffix         = ":pending-assignment"
+)
+
+// PendingAssignmentStateValue is the credential assigned under a multi-audit
+// template. The credential is activated when the approvals reach the
+// threshold of the auditor set at the assignment.
+type PendingAssignmentStateValue struct {
+	hint.BaseHinter
+	Credential types.Credential
+	Assigner   base.Address
+	Threshold  uint
+	Approvals  []base.Address
+	IssuerSign *types.IssuerSign
+}
+
+func NewPendingAssignmentStateValue(
+	credential types.Credential, assigner base.Address, threshold uint, approvals []base.Address,
+) PendingAssignmentStateValue {
+	return PendingAssignmentStateValue{
+		BaseHinter: hint.NewBaseHinter(PendingAssignmentStateValueHint),
+		Credential: credential,
+		Assigner:   assigner,
+		Threshold:  threshold,
+		Approvals:  approvals,
+	}
+}
+
+func (pa PendingAssignmentStateValue) Hint() hint.Hint {
+	return pa.BaseHinter.Hint()
+}
+
+func (pa PendingAssignmentStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid PendingAssignmentStateValue")
+
+	if err := pa.BaseHinter.IsValid(PendingAssignmentStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, pa.Credential, pa.Assigner); err != nil {
+		return e.Wrap(err)
+	}
+
+	if pa.Threshold < 1 {
+		return e.Wrap(errors.Errorf("zero threshold"))
+	}
+
+	founds := map[string]struct{}{}
+	for i := range pa.Approvals {
+		if err := pa.Approvals[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		if _, found := founds[pa.Approvals[i].String()]; found {
+			return e.Wrap(errors.Errorf("duplicate approval found, %q", pa.Approvals[i]))
+		}
+		founds[pa.Approvals[i].String()] = struct{}{}
+	}
+
+	if pa.IssuerSign != nil {
+		if err := pa.IssuerSign.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (pa PendingAssignmentStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(pa.Approvals))
+	for i := range pa.Approvals {
+		bs[i] = pa.Approvals[i].Bytes()
+	}
+
+	var issuerSign []byte
+	if pa.IssuerSign != nil {
+		issuerSign = pa.IssuerSign.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		pa.Credential.Bytes(),
+		pa.Assigner.Bytes(),
+		util.UintToBytes(pa.Threshold),
+		util.ConcatBytesSlice(bs...),
+		issuerSign,
+	)
+}
+
+// IsApproved reports whether the approvals reached the threshold, that is,
+// the credential was activated.
+func (pa PendingAssignmentStateValue) IsApproved() bool {
+	return uint(len(pa.Approvals)) >= pa.Threshold
+}
+
+func (pa PendingAssignmentStateValue) HasApproved(auditor base.Address) bool {
+	for i := range pa.Approvals {
+		if pa.Approvals[i].Equal(auditor) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func StatePendingAssignmentValue(st base.State) (PendingAssignmentStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return PendingAssignmentStateValue{}, util.ErrNotFound.Errorf("pending assignment not found in State")
+	}
+
+	pa, ok := v.(PendingAssignmentStateValue)
+	if !ok {
+		return PendingAssignmentStateValue{}, errors.Errorf("invalid pending assignment value found, %T", v)
+	}
+
+	return pa, nil
+}
+
+func IsStatePendingAssignmentKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, PendingAssignmentSuffix)
+}
+
+func StateKeyPendingAssignment(contract base.Address, templateID string, id string) string {
+	return fmt.Sprintf("%s:%s:%s%s", StateKeyCredentialPrefix(contract), templateID, id, PendingAssignmentSuffix)
+}
+
+var (
+	TemplateGrantStateValueHint = hint.MustNewHint("mitum-credential-template-grant-state-value-v0.0.1")
+	TemplateGrantSuffix         = ":template-grant"
+)
+
+// TemplateGrantStateValue is kept under the grantee service and points to
+// the service which owns the shared template.
+type TemplateGrantStateValue struct {
+	hint.BaseHinter
+	owner base.Address
+}
+
+func NewTemplateGrantStateValue(owner base.Address) TemplateGrantStateValue {
+	return TemplateGrantStateValue{
+		BaseHinter: hint.NewBaseHinter(TemplateGrantStateValueHint),
+		owner:      owner,
+	}
+}
+
+func (tg TemplateGrantStateValue) Hint() hint.Hint {
+	return tg.BaseHinter.Hint()
+}
+
+func (tg TemplateGrantStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential TemplateGrantStateValue")
+
+	if err := tg.BaseHinter.IsValid(TemplateGrantStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := util.CheckIsValiders(nil, false, tg.owner); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (tg TemplateGrantStateValue) HashBytes() []byte {
+	return tg.owner.Bytes()
+}
+
+func StateTemplateGrantValue(st base.State) (base.Address, error) {
+	v := st.Value()
+	if v == nil {
+		return nil, util.ErrNotFound.Errorf("template grant not found in State")
+	}
+
+	tg, ok := v.(TemplateGrantStateValue)
+	if !ok {
+		return nil, errors.Errorf("invalid template grant value found, %T", v)
+	}
+
+	return tg.owner, nil
+}
+
+func IsStateTemplateGrantKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, TemplateGrantSuffix)
+}
+
+func StateKeyTemplateGrant(grantee base.Address, templateID string) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(grantee), templateID, TemplateGrantSuffix)
+}
+
+var (
+	RolesStateValueHint = hint.MustNewHint("mitum-credential-roles-state-value-v0.0.1")
+	RolesSuffix         = ":roles"
+)
+
+// RolesStateValue keeps the roles of the account in the credential service.
+type RolesStateValue struct {
+	hint.BaseHinter
+	Roles []types.RoleGrant
+}
+
+func NewRolesStateValue(roles []types.RoleGrant) RolesStateValue {
+	return RolesStateValue{
+		BaseHinter: hint.NewBaseHinter(RolesStateValueHint),
+		Roles:      roles,
+	}
+}
+
+func (rs RolesStateValue) Hint() hint.Hint {
+	return rs.BaseHinter.Hint()
+}
+
+func (rs RolesStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential RolesStateValue")
+
+	if err := rs.BaseHinter.IsValid(RolesStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	for i := range rs.Roles {
+		if err := rs.Roles[i].IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+
+		for j := range rs.Roles[:i] {
+			if rs.Roles[i].Equal(rs.Roles[j]) {
+				return e.Wrap(util.ErrInvalid.Errorf("duplicate role, %q, %q", rs.Roles[i].Role(), rs.Roles[i].TemplateID()))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (rs RolesStateValue) HashBytes() []byte {
+	bs := make([][]byte, len(rs.Roles))
+	for i := range rs.Roles {
+		bs[i] = rs.Roles[i].Bytes()
+	}
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+// Has reports whether the roles give the role for the template.
+func (rs RolesStateValue) Has(role types.Role, templateID string) bool {
+	for i := range rs.Roles {
+		if rs.Roles[i].Covers(role, templateID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeRoleGrants(enc encoder.Encoder, b []byte) ([]types.RoleGrant, error) {
+	hs, err := enc.DecodeSlice(b)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]types.RoleGrant, len(hs))
+	for i := range hs {
+		r, ok := hs[i].(types.RoleGrant)
+		if !ok {
+			return nil, errors.Errorf("expected RoleGrant, not %T", hs[i])
+		}
+
+		roles[i] = r
+	}
+
+	return roles, nil
+}
+
+func StateRolesValue(st base.State) (RolesStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return RolesStateValue{}, util.ErrNotFound.Errorf("roles not found in State")
+	}
+
+	rs, ok := v.(RolesStateValue)
+	if !ok {
+		return RolesStateValue{}, errors.Errorf("invalid roles value found, %T", v)
+	}
+
+	return rs, nil
+}
+
+func IsStateRolesKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, RolesSuffix)
+}
+
+func StateKeyRoles(contract base.Address, account base.Address) string {
+	return fmt.Sprintf("%s:%s%s", StateKeyCredentialPrefix(contract), account.String(), RolesSuffix)
+}
+
+var (
+	BatchAnchorStateValueHint = hint.MustNewHint("mitum-credential-batch-anchor-state-value-v0.0.1")
+	BatchAnchorSuffix         = ":batch-anchor"
+)
+
+// BatchAnchorStateValue keeps the merkle root of the credentials issued off
+// chain in a batch. Revocation is set when the whole batch is revoked.
+type BatchAnchorStateValue struct {
+	hint.BaseHinter
+	Root       string
+	Count      uint64
+	Revocation *types.Revocation
+}
+
+func NewBatchAnchorStateValue(root string, count uint64) BatchAnchorStateValue {
+	return BatchAnchorStateValue{
+		BaseHinter: hint.NewBaseHinter(BatchAnchorStateValueHint),
+		Root:       root,
+		Count:      count,
+	}
+}
+
+func (ba BatchAnchorStateValue) Hint() hint.Hint {
+	return ba.BaseHinter.Hint()
+}
+
+func (ba BatchAnchorStateValue) IsValid([]byte) error {
+	e := util.ErrInvalid.Errorf("invalid credential BatchAnchorStateValue")
+
+	if err := ba.BaseHinter.IsValid(BatchAnchorStateValueHint.Type().Bytes()); err != nil {
+		return e.Wrap(err)
+	}
+
+	if err := types.IsValidMerkleRoot(ba.Root); err != nil {
+		return e.Wrap(err)
+	}
+
+	if ba.Count < 1 {
+		return e.Wrap(errors.Errorf("empty batch"))
+	}
+
+	if ba.Revocation != nil {
+		if err := ba.Revocation.IsValid(nil); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+func (ba BatchAnchorStateValue) HashBytes() []byte {
+	var revocation []byte
+	if ba.Revocation != nil {
+		revocation = ba.Revocation.Bytes()
+	}
+
+	return util.ConcatBytesSlice(
+		[]byte(ba.Root),
+		util.Uint64ToBytes(ba.Count),
+		revocation,
+	)
+}
+
+func (ba BatchAnchorStateValue) IsRevoked() bool {
+	return ba.Revocation != nil
+}
+
+func StateBatchAnchorValue(st base.State) (BatchAnchorStateValue, error) {
+	v := st.Value()
+	if v == nil {
+		return BatchAnchorStateValue{}, util.ErrNotFound.Errorf("batch anchor not found in State")
+	}
+
+	ba, ok := v.(BatchAnchorStateValue)
+	if !ok {
+		return BatchAnchorStateValue{}, errors.Errorf("invalid batch anchor value found, %T", v)
+	}
+
+	return ba, nil
+}
+
+func IsStateBatchAnchorKey(key string) bool {
+	return strings.HasPrefix(key, CredentialPrefix) && strings.HasSuffix(key, BatchAnchorSuffix)
+}
+
+func StateKeyBatchAnchor(contract base.Address, templateID string, batchID string) string {
+	return fmt.Sprintf("%s:%s:%s%s", StateKeyCredentialPrefix(contract), templateID, batchID, BatchAnchorSuffix)
+}
+
 func ParseStateKey(key string, Prefix string) ([]string, error) {
 	parsedKey := strings.Split(key, ":")
 	if parsedKey[0] != Prefix[:len(Prefix)-1] {
@@ -3,6 +3,7 @@ package state
 import (
 	"github.com/ProtoconNet/mitum-credential/types"
 	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
 	"go.mongodb.org/mongo-driver/bson"
@@ -56,6 +57,7 @@ func (t TemplateStateValue) MarshalBSON() ([]byte, error) {
 		bson.M{
 			"_hint":    t.Hint().String(),
 			"template": t.Template,
+			"status":   t.Status,
 		},
 	)
 }
@@ -63,6 +65,7 @@ func (t TemplateStateValue) MarshalBSON() ([]byte, error) {
 type TemplateStateValueBSONUnmarshaler struct {
 	Hint     string   `bson:"_hint"`
 	Template bson.Raw `bson:"template"`
+	Status   string   `bson:"status"`
 }
 
 func (t *TemplateStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -86,6 +89,10 @@ func (t *TemplateStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 	}
 
 	t.Template = template
+	t.Status = types.TemplateStatus(u.Status)
+	if len(t.Status) == 0 {
+		t.Status = types.TemplateStatusActive
+	}
 
 	if err := t.IsValid(nil); err != nil {
 		return e.Wrap(err)
@@ -95,19 +102,35 @@ func (t *TemplateStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (cd CredentialStateValue) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":      cd.Hint().String(),
-			"credential": cd.Credential,
-			"is_active":  cd.IsActive,
-		},
-	)
+	m := bson.M{
+		"_hint":      cd.Hint().String(),
+		"credential": cd.Credential,
+		"status":     cd.Status,
+		"is_active":  cd.IsActive(),
+		"version":    cd.Version,
+	}
+	if cd.Revocation != nil {
+		m["revocation"] = cd.Revocation
+	}
+	if cd.StatusIndex != nil {
+		m["status_index"] = *cd.StatusIndex
+	}
+	if cd.IssuerSign != nil {
+		m["issuer_sign"] = cd.IssuerSign
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type CredentialStateValueBSONUnmarshaler struct {
-	Hint       string   `bson:"_hint"`
-	Credential bson.Raw `bson:"credential"`
-	IsActive   bool     `bson:"is_active"`
+	Hint        string   `bson:"_hint"`
+	Credential  bson.Raw `bson:"credential"`
+	Status      string   `bson:"status"`
+	IsActive    bool     `bson:"is_active"`
+	Version     uint64   `bson:"version"`
+	Revocation  bson.Raw `bson:"revocation,omitempty"`
+	StatusIndex *uint64  `bson:"status_index,omitempty"`
+	IssuerSign  bson.Raw `bson:"issuer_sign,omitempty"`
 }
 
 func (cd *CredentialStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -131,7 +154,31 @@ func (cd *CredentialStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 	}
 
 	cd.Credential = credential
-	cd.IsActive = u.IsActive
+	cd.Status = types.CredentialStatus(u.Status)
+	if len(cd.Status) == 0 {
+		cd.Status = types.CredentialStatusRevoked
+		if u.IsActive {
+			cd.Status = types.CredentialStatusActive
+		}
+	}
+	cd.Version = u.Version
+	cd.StatusIndex = u.StatusIndex
+
+	if len(u.Revocation) > 0 {
+		var revocation types.Revocation
+		if err := revocation.DecodeBSON(u.Revocation, enc); err != nil {
+			return e.Wrap(err)
+		}
+		cd.Revocation = &revocation
+	}
+
+	if len(u.IssuerSign) > 0 {
+		var issuerSign types.IssuerSign
+		if err := issuerSign.DecodeBSON(u.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+		cd.IssuerSign = &issuerSign
+	}
 
 	if err := cd.IsValid(nil); err != nil {
 		return e.Wrap(err)
@@ -175,3 +222,411 @@ func (hd *HolderDIDStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error
 
 	return nil
 }
+
+func (dh DIDHolderStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  dh.Hint().String(),
+			"holder": dh.holder,
+		},
+	)
+}
+
+type DIDHolderStateValueBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Holder string `bson:"holder"`
+}
+
+func (dh *DIDHolderStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of DIDHolderStateValue")
+
+	var u DIDHolderStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	dh.BaseHinter = hint.NewBaseHinter(ht)
+
+	holder, err := base.DecodeAddress(u.Holder, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	dh.holder = holder
+
+	if err := dh.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (hs HolderStatStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":            hs.Hint().String(),
+			"credential_count": hs.credentialCount,
+		},
+	)
+}
+
+type HolderStatStateValueBSONUnmarshaler struct {
+	Hint            string `bson:"_hint"`
+	CredentialCount uint64 `bson:"credential_count"`
+}
+
+func (hs *HolderStatStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of HolderStatStateValue")
+
+	var u HolderStatStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	hs.BaseHinter = hint.NewBaseHinter(ht)
+	hs.credentialCount = u.CredentialCount
+
+	if err := hs.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (sl StatusListStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       sl.Hint().String(),
+			"status_list": sl.StatusList,
+		},
+	)
+}
+
+type StatusListStateValueBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	StatusList bson.Raw `bson:"status_list"`
+}
+
+func (sl *StatusListStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of StatusListStateValue")
+
+	var u StatusListStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	sl.BaseHinter = hint.NewBaseHinter(ht)
+
+	var statusList types.StatusList
+	if err := statusList.DecodeBSON(u.StatusList, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	sl.StatusList = statusList
+
+	if err := sl.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ss StatusListSizeStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": ss.Hint().String(),
+			"size":  ss.size,
+		},
+	)
+}
+
+type StatusListSizeStateValueBSONUnmarshaler struct {
+	Hint string `bson:"_hint"`
+	Size uint64 `bson:"size"`
+}
+
+func (ss *StatusListSizeStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of StatusListSizeStateValue")
+
+	var u StatusListSizeStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ss.BaseHinter = hint.NewBaseHinter(ht)
+	ss.size = u.Size
+
+	if err := ss.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (pa PendingAssignmentStateValue) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint":      pa.Hint().String(),
+		"credential": pa.Credential,
+		"assigner":   pa.Assigner,
+		"threshold":  pa.Threshold,
+		"approvals":  pa.Approvals,
+	}
+	if pa.IssuerSign != nil {
+		m["issuer_sign"] = pa.IssuerSign
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type PendingAssignmentStateValueBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Credential bson.Raw `bson:"credential"`
+	Assigner   string   `bson:"assigner"`
+	Threshold  uint     `bson:"threshold"`
+	Approvals  []string `bson:"approvals"`
+	IssuerSign bson.Raw `bson:"issuer_sign,omitempty"`
+}
+
+func (pa *PendingAssignmentStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of PendingAssignmentStateValue")
+
+	var u PendingAssignmentStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	pa.BaseHinter = hint.NewBaseHinter(ht)
+
+	var credential types.Credential
+	if err := credential.DecodeBSON(u.Credential, enc); err != nil {
+		return e.Wrap(err)
+	}
+	pa.Credential = credential
+
+	assigner, err := base.DecodeAddress(u.Assigner, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	pa.Assigner = assigner
+	pa.Threshold = u.Threshold
+
+	pa.Approvals = make([]base.Address, len(u.Approvals))
+	for i := range u.Approvals {
+		a, err := base.DecodeAddress(u.Approvals[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		pa.Approvals[i] = a
+	}
+
+	if len(u.IssuerSign) > 0 {
+		var issuerSign types.IssuerSign
+		if err := issuerSign.DecodeBSON(u.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+		pa.IssuerSign = &issuerSign
+	}
+
+	if err := pa.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (tg TemplateGrantStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": tg.Hint().String(),
+			"owner": tg.owner,
+		},
+	)
+}
+
+type TemplateGrantStateValueBSONUnmarshaler struct {
+	Hint  string `bson:"_hint"`
+	Owner string `bson:"owner"`
+}
+
+func (tg *TemplateGrantStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TemplateGrantStateValue")
+
+	var u TemplateGrantStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	tg.BaseHinter = hint.NewBaseHinter(ht)
+
+	owner, err := base.DecodeAddress(u.Owner, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	tg.owner = owner
+
+	if err := tg.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (rs RolesStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint": rs.Hint().String(),
+			"roles": rs.Roles,
+		},
+	)
+}
+
+type RolesStateValueBSONUnmarshaler struct {
+	Hint  string   `bson:"_hint"`
+	Roles bson.Raw `bson:"roles"`
+}
+
+func (rs *RolesStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RolesStateValue")
+
+	var u RolesStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	rs.BaseHinter = hint.NewBaseHinter(ht)
+
+	roles, err := decodeRoleGrants(enc, u.Roles)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	rs.Roles = roles
+
+	if err := rs.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ts TemplateStatStateValue) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":            ts.Hint().String(),
+			"credential_count": ts.credentialCount,
+		},
+	)
+}
+
+type TemplateStatStateValueBSONUnmarshaler struct {
+	Hint            string `bson:"_hint"`
+	CredentialCount uint64 `bson:"credential_count"`
+}
+
+func (ts *TemplateStatStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of TemplateStatStateValue")
+
+	var u TemplateStatStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ts.BaseHinter = hint.NewBaseHinter(ht)
+	ts.credentialCount = u.CredentialCount
+
+	if err := ts.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+func (ba BatchAnchorStateValue) MarshalBSON() ([]byte, error) {
+	m := bson.M{
+		"_hint": ba.Hint().String(),
+		"root":  ba.Root,
+		"count": ba.Count,
+	}
+	if ba.Revocation != nil {
+		m["revocation"] = ba.Revocation
+	}
+
+	return bsonenc.Marshal(m)
+}
+
+type BatchAnchorStateValueBSONUnmarshaler struct {
+	Hint       string   `bson:"_hint"`
+	Root       string   `bson:"root"`
+	Count      uint64   `bson:"count"`
+	Revocation bson.Raw `bson:"revocation,omitempty"`
+}
+
+func (ba *BatchAnchorStateValue) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of BatchAnchorStateValue")
+
+	var u BatchAnchorStateValueBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	ba.BaseHinter = hint.NewBaseHinter(ht)
+	ba.Root = u.Root
+	ba.Count = u.Count
+
+	if len(u.Revocation) > 0 {
+		var revocation types.Revocation
+		if err := revocation.DecodeBSON(u.Revocation, enc); err != nil {
+			return e.Wrap(err)
+		}
+		ba.Revocation = &revocation
+	}
+
+	if err := ba.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
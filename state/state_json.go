@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"github.com/ProtoconNet/mitum-credential/types"
 
+	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -53,19 +54,22 @@ func (de *DesignStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type TemplateStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Template types.Template `json:"template"`
+	Template types.Template       `json:"template"`
+	Status   types.TemplateStatus `json:"status"`
 }
 
 func (t TemplateStateValue) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(TemplateStateValueJSONMarshaler{
 		BaseHinter: t.BaseHinter,
 		Template:   t.Template,
+		Status:     t.Status,
 	})
 }
 
 type TemplateStateValueJSONUnmarshaler struct {
 	Hint     hint.Hint       `json:"_hint"`
 	Template json.RawMessage `json:"template"`
+	Status   string          `json:"status"`
 }
 
 func (t *TemplateStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -85,6 +89,10 @@ func (t *TemplateStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 	}
 
 	t.Template = template
+	t.Status = types.TemplateStatus(u.Status)
+	if len(t.Status) == 0 {
+		t.Status = types.TemplateStatusActive
+	}
 
 	if err := t.IsValid(nil); err != nil {
 		return e.Wrap(err)
@@ -94,22 +102,37 @@ func (t *TemplateStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type CredentialStateValueJSONMarshaler struct {
 	hint.BaseHinter
-	Credential types.Credential `json:"credential"`
-	IsActive   bool             `json:"is_active"`
+	Credential  types.Credential       `json:"credential"`
+	Status      types.CredentialStatus `json:"status"`
+	IsActive    bool                   `json:"is_active"`
+	Version     uint64                 `json:"version"`
+	Revocation  *types.Revocation      `json:"revocation,omitempty"`
+	StatusIndex *uint64                `json:"status_index,omitempty"`
+	IssuerSign  *types.IssuerSign      `json:"issuer_sign,omitempty"`
 }
 
 func (cd CredentialStateValue) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(CredentialStateValueJSONMarshaler{
-		BaseHinter: cd.BaseHinter,
-		Credential: cd.Credential,
-		IsActive:   cd.IsActive,
+		BaseHinter:  cd.BaseHinter,
+		Credential:  cd.Credential,
+		Status:      cd.Status,
+		IsActive:    cd.IsActive(),
+		Version:     cd.Version,
+		Revocation:  cd.Revocation,
+		StatusIndex: cd.StatusIndex,
+		IssuerSign:  cd.IssuerSign,
 	})
 }
 
 type CredentialStateValueJSONUnmarshaler struct {
-	Hint       hint.Hint       `json:"_hint"`
-	Credential json.RawMessage `json:"credential"`
-	IsActive   bool            `json:"is_active"`
+	Hint        hint.Hint       `json:"_hint"`
+	Credential  json.RawMessage `json:"credential"`
+	Status      string          `json:"status"`
+	IsActive    bool            `json:"is_active"`
+	Version     uint64          `json:"version"`
+	Revocation  json.RawMessage `json:"revocation"`
+	StatusIndex *uint64         `json:"status_index"`
+	IssuerSign  json.RawMessage `json:"issuer_sign"`
 }
 
 func (cd *CredentialStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -129,7 +152,31 @@ func (cd *CredentialStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error
 	}
 
 	cd.Credential = credential
-	cd.IsActive = u.IsActive
+	cd.Status = types.CredentialStatus(u.Status)
+	if len(cd.Status) == 0 {
+		cd.Status = types.CredentialStatusRevoked
+		if u.IsActive {
+			cd.Status = types.CredentialStatusActive
+		}
+	}
+	cd.Version = u.Version
+	cd.StatusIndex = u.StatusIndex
+
+	if len(u.Revocation) > 0 && string(u.Revocation) != "null" {
+		var revocation types.Revocation
+		if err := revocation.DecodeJSON(u.Revocation, enc); err != nil {
+			return e.Wrap(err)
+		}
+		cd.Revocation = &revocation
+	}
+
+	if len(u.IssuerSign) > 0 && string(u.IssuerSign) != "null" {
+		var issuerSign types.IssuerSign
+		if err := issuerSign.DecodeJSON(u.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+		cd.IssuerSign = &issuerSign
+	}
 
 	if err := cd.IsValid(nil); err != nil {
 		return e.Wrap(err)
@@ -171,3 +218,389 @@ func (hd *HolderDIDStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error
 	}
 	return nil
 }
+
+type DIDHolderStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Holder base.Address `json:"holder"`
+}
+
+func (dh DIDHolderStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(DIDHolderStateValueJSONMarshaler{
+		BaseHinter: dh.BaseHinter,
+		Holder:     dh.holder,
+	})
+}
+
+type DIDHolderStateValueJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Holder string    `json:"holder"`
+}
+
+func (dh *DIDHolderStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of DIDHolderStateValue")
+
+	var u DIDHolderStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	dh.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	holder, err := base.DecodeAddress(u.Holder, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	dh.holder = holder
+
+	if err := dh.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+	return nil
+}
+
+type HolderStatStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	CredentialCount uint64 `json:"credential_count"`
+}
+
+func (hs HolderStatStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(HolderStatStateValueJSONMarshaler{
+		BaseHinter:      hs.BaseHinter,
+		CredentialCount: hs.credentialCount,
+	})
+}
+
+type HolderStatStateValueJSONUnmarshaler struct {
+	Hint            hint.Hint `json:"_hint"`
+	CredentialCount uint64    `json:"credential_count"`
+}
+
+func (hs *HolderStatStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of HolderStatStateValue")
+
+	var u HolderStatStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	hs.BaseHinter = hint.NewBaseHinter(u.Hint)
+	hs.credentialCount = u.CredentialCount
+
+	if err := hs.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+	return nil
+}
+
+type StatusListStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	StatusList types.StatusList `json:"status_list"`
+}
+
+func (sl StatusListStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StatusListStateValueJSONMarshaler{
+		BaseHinter: sl.BaseHinter,
+		StatusList: sl.StatusList,
+	})
+}
+
+type StatusListStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	StatusList json.RawMessage `json:"status_list"`
+}
+
+func (sl *StatusListStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of StatusListStateValue")
+
+	var u StatusListStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	sl.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var statusList types.StatusList
+	if err := statusList.DecodeJSON(u.StatusList, enc); err != nil {
+		return e.Wrap(err)
+	}
+
+	sl.StatusList = statusList
+
+	if err := sl.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type StatusListSizeStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Size uint64 `json:"size"`
+}
+
+func (ss StatusListSizeStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StatusListSizeStateValueJSONMarshaler{
+		BaseHinter: ss.BaseHinter,
+		Size:       ss.size,
+	})
+}
+
+type StatusListSizeStateValueJSONUnmarshaler struct {
+	Hint hint.Hint `json:"_hint"`
+	Size uint64    `json:"size"`
+}
+
+func (ss *StatusListSizeStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of StatusListSizeStateValue")
+
+	var u StatusListSizeStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ss.BaseHinter = hint.NewBaseHinter(u.Hint)
+	ss.size = u.Size
+
+	if err := ss.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type PendingAssignmentStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Credential types.Credential  `json:"credential"`
+	Assigner   base.Address      `json:"assigner"`
+	Threshold  uint              `json:"threshold"`
+	Approvals  []base.Address    `json:"approvals"`
+	IssuerSign *types.IssuerSign `json:"issuer_sign,omitempty"`
+}
+
+func (pa PendingAssignmentStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(PendingAssignmentStateValueJSONMarshaler{
+		BaseHinter: pa.BaseHinter,
+		Credential: pa.Credential,
+		Assigner:   pa.Assigner,
+		Threshold:  pa.Threshold,
+		Approvals:  pa.Approvals,
+		IssuerSign: pa.IssuerSign,
+	})
+}
+
+type PendingAssignmentStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Credential json.RawMessage `json:"credential"`
+	Assigner   string          `json:"assigner"`
+	Threshold  uint            `json:"threshold"`
+	Approvals  []string        `json:"approvals"`
+	IssuerSign json.RawMessage `json:"issuer_sign"`
+}
+
+func (pa *PendingAssignmentStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of PendingAssignmentStateValue")
+
+	var u PendingAssignmentStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	pa.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	var credential types.Credential
+	if err := credential.DecodeJSON(u.Credential, enc); err != nil {
+		return e.Wrap(err)
+	}
+	pa.Credential = credential
+
+	assigner, err := base.DecodeAddress(u.Assigner, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	pa.Assigner = assigner
+	pa.Threshold = u.Threshold
+
+	pa.Approvals = make([]base.Address, len(u.Approvals))
+	for i := range u.Approvals {
+		a, err := base.DecodeAddress(u.Approvals[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		pa.Approvals[i] = a
+	}
+
+	if len(u.IssuerSign) > 0 && string(u.IssuerSign) != "null" {
+		var issuerSign types.IssuerSign
+		if err := issuerSign.DecodeJSON(u.IssuerSign, enc); err != nil {
+			return e.Wrap(err)
+		}
+		pa.IssuerSign = &issuerSign
+	}
+
+	if err := pa.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
+
+type TemplateGrantStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Owner base.Address `json:"owner"`
+}
+
+func (tg TemplateGrantStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TemplateGrantStateValueJSONMarshaler{
+		BaseHinter: tg.BaseHinter,
+		Owner:      tg.owner,
+	})
+}
+
+type TemplateGrantStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint `json:"_hint"`
+	Owner string    `json:"owner"`
+}
+
+func (tg *TemplateGrantStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of TemplateGrantStateValue")
+
+	var u TemplateGrantStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	tg.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	owner, err := base.DecodeAddress(u.Owner, enc)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	tg.owner = owner
+
+	if err := tg.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+	return nil
+}
+
+type RolesStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Roles []types.RoleGrant `json:"roles"`
+}
+
+func (rs RolesStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RolesStateValueJSONMarshaler{
+		BaseHinter: rs.BaseHinter,
+		Roles:      rs.Roles,
+	})
+}
+
+type RolesStateValueJSONUnmarshaler struct {
+	Hint  hint.Hint       `json:"_hint"`
+	Roles json.RawMessage `json:"roles"`
+}
+
+func (rs *RolesStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RolesStateValue")
+
+	var u RolesStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	rs.BaseHinter = hint.NewBaseHinter(u.Hint)
+
+	roles, err := decodeRoleGrants(enc, u.Roles)
+	if err != nil {
+		return e.Wrap(err)
+	}
+	rs.Roles = roles
+
+	if err := rs.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+	return nil
+}
+
+type TemplateStatStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	CredentialCount uint64 `json:"credential_count"`
+}
+
+func (ts TemplateStatStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(TemplateStatStateValueJSONMarshaler{
+		BaseHinter:      ts.BaseHinter,
+		CredentialCount: ts.credentialCount,
+	})
+}
+
+type TemplateStatStateValueJSONUnmarshaler struct {
+	Hint            hint.Hint `json:"_hint"`
+	CredentialCount uint64    `json:"credential_count"`
+}
+
+func (ts *TemplateStatStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of TemplateStatStateValue")
+
+	var u TemplateStatStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ts.BaseHinter = hint.NewBaseHinter(u.Hint)
+	ts.credentialCount = u.CredentialCount
+
+	if err := ts.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+	return nil
+}
+
+type BatchAnchorStateValueJSONMarshaler struct {
+	hint.BaseHinter
+	Root       string            `json:"root"`
+	Count      uint64            `json:"count"`
+	Revocation *types.Revocation `json:"revocation,omitempty"`
+}
+
+func (ba BatchAnchorStateValue) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(BatchAnchorStateValueJSONMarshaler{
+		BaseHinter: ba.BaseHinter,
+		Root:       ba.Root,
+		Count:      ba.Count,
+		Revocation: ba.Revocation,
+	})
+}
+
+type BatchAnchorStateValueJSONUnmarshaler struct {
+	Hint       hint.Hint       `json:"_hint"`
+	Root       string          `json:"root"`
+	Count      uint64          `json:"count"`
+	Revocation json.RawMessage `json:"revocation"`
+}
+
+func (ba *BatchAnchorStateValue) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of BatchAnchorStateValue")
+
+	var u BatchAnchorStateValueJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ba.BaseHinter = hint.NewBaseHinter(u.Hint)
+	ba.Root = u.Root
+	ba.Count = u.Count
+
+	if len(u.Revocation) > 0 && string(u.Revocation) != "null" {
+		var revocation types.Revocation
+		if err := revocation.DecodeJSON(u.Revocation, enc); err != nil {
+			return e.Wrap(err)
+		}
+		ba.Revocation = &revocation
+	}
+
+	if err := ba.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var AuditorSetHint = hint.MustNewHint("mitum-credential-auditor-set-v0.0.1")
+
+var MaxAuditors = 20
+
+// AuditorSet is the auditors of a multi-audit template; an assignment under
+// the template is activated by the approvals of threshold auditors.
+type AuditorSet struct {
+	hint.BaseHinter
+	auditors  []base.Address
+	threshold uint
+}
+
+func NewAuditorSet(auditors []base.Address, threshold uint) AuditorSet {
+	return AuditorSet{
+		BaseHinter: hint.NewBaseHinter(AuditorSetHint),
+		auditors:   auditors,
+		threshold:  threshold,
+	}
+}
+
+func (s AuditorSet) Bytes() []byte {
+	bs := make([][]byte, len(s.auditors)+1)
+	for i := range s.auditors {
+		bs[i] = s.auditors[i].Bytes()
+	}
+	bs[len(s.auditors)] = util.UintToBytes(s.threshold)
+
+	return util.ConcatBytesSlice(bs...)
+}
+
+func (s AuditorSet) IsValid([]byte) error {
+	if err := s.BaseHinter.IsValid(AuditorSetHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	if n := len(s.auditors); n < 1 || n > MaxAuditors {
+		return util.ErrInvalid.Errorf("invalid number of auditors, 0 < number <= %d", MaxAuditors)
+	}
+
+	founds := map[string]struct{}{}
+	for i := range s.auditors {
+		if err := s.auditors[i].IsValid(nil); err != nil {
+			return err
+		}
+
+		if _, found := founds[s.auditors[i].String()]; found {
+			return util.ErrInvalid.Errorf("duplicate auditor found, %q", s.auditors[i])
+		}
+		founds[s.auditors[i].String()] = struct{}{}
+	}
+
+	if s.threshold < 1 || s.threshold > uint(len(s.auditors)) {
+		return util.ErrInvalid.Errorf("invalid audit threshold, 0 < threshold <= %d", len(s.auditors))
+	}
+
+	return nil
+}
+
+func (s AuditorSet) Auditors() []base.Address {
+	return s.auditors
+}
+
+func (s AuditorSet) Threshold() uint {
+	return s.threshold
+}
+
+func (s AuditorSet) IsAuditor(a base.Address) bool {
+	for i := range s.auditors {
+		if s.auditors[i].Equal(a) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s AuditorSet) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     s.Hint().String(),
+			"auditors":  s.auditors,
+			"threshold": s.threshold,
+		},
+	)
+}
+
+type AuditorSetBSONUnmarshaler struct {
+	Hint      string   `bson:"_hint"`
+	Auditors  []string `bson:"auditors"`
+	Threshold uint     `bson:"threshold"`
+}
+
+func (s *AuditorSet) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of AuditorSet")
+
+	var u AuditorSetBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Auditors, u.Threshold)
+}
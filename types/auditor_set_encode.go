@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s *AuditorSet) unpack(enc encoder.Encoder, ht hint.Hint, auditors []string, threshold uint) error {
+	e := util.StringError("failed to unpack of AuditorSet")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.threshold = threshold
+
+	s.auditors = make([]base.Address, len(auditors))
+	for i := range auditors {
+		a, err := base.DecodeAddress(auditors[i], enc)
+		if err != nil {
+			return e.Wrap(err)
+		}
+		s.auditors[i] = a
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type AuditorSetJSONMarshaler struct {
+	hint.BaseHinter
+	Auditors  []base.Address `json:"auditors"`
+	Threshold uint           `json:"threshold"`
+}
+
+func (s AuditorSet) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(AuditorSetJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Auditors:   s.auditors,
+		Threshold:  s.threshold,
+	})
+}
+
+type AuditorSetJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Auditors  []string  `json:"auditors"`
+	Threshold uint      `json:"threshold"`
+}
+
+func (s *AuditorSet) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of AuditorSet")
+
+	var u AuditorSetJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Auditors, u.Threshold)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -63,7 +65,47 @@ func (it Credential) Bytes() []byte {
 	)
 }
 
+// CanonicalBytes is the encoding of credential signed by issuer and hashed
+// in the merkle tree of batch; each field is prefixed with its length, so
+// that the boundaries of the fields can not be moved.
+func (it Credential) CanonicalBytes() []byte {
+	var holder []byte
+	if it.holder != nil {
+		holder = it.holder.Bytes()
+	}
+
+	return lengthPrefixedBytes(
+		holder,
+		[]byte(it.templateID),
+		[]byte(it.id),
+		[]byte(it.value),
+		util.Uint64ToBytes(it.validFrom),
+		util.Uint64ToBytes(it.validUntil),
+		[]byte(it.did),
+	)
+}
+
+func lengthPrefixedBytes(bs ...[]byte) []byte {
+	var b bytes.Buffer
+	for i := range bs {
+		_, _ = b.Write(util.Uint64ToBytes(uint64(len(bs[i]))))
+		_, _ = b.Write(bs[i])
+	}
+
+	return b.Bytes()
+}
+
 func (it Credential) IsValid([]byte) error {
+	if err := it.isValid(); err != nil {
+		return err
+	}
+
+	return DID(it.did).IsValid(nil)
+}
+
+// isValid checks the credential except did syntax, so credentials assigned
+// before did syntax check can still be decoded.
+func (it Credential) isValid() error {
 	if err := util.CheckIsValiders(nil, false,
 		it.BaseHinter,
 	); err != nil {
@@ -30,7 +30,7 @@ func (t *Credential) unpack(enc encoder.Encoder, ht hint.Hint,
 	t.templateID = tmplID
 	t.validFrom = vFrom
 	t.validUntil = vUntil
-	if err := t.IsValid(nil); err != nil {
+	if err := t.isValid(); err != nil {
 		return e.Wrap(err)
 	}
 
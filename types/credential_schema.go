@@ -0,0 +1,83 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/pkg/errors"
+)
+
+var CredentialSchemaHint = hint.MustNewHint("mitum-credential-credential-schema-v0.0.1")
+
+var MaxLengthCredentialSchema = 4096
+
+// CredentialSchema describes the credential value of template with the
+// restricted subset of JSON Schema.
+type CredentialSchema struct {
+	hint.BaseHinter
+	schema string
+}
+
+func NewCredentialSchema(schema string) CredentialSchema {
+	return CredentialSchema{
+		BaseHinter: hint.NewBaseHinter(CredentialSchemaHint),
+		schema:     schema,
+	}
+}
+
+func (s CredentialSchema) Bytes() []byte {
+	return []byte(s.schema)
+}
+
+func (s CredentialSchema) IsValid([]byte) error {
+	if err := s.BaseHinter.IsValid(CredentialSchemaHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	if l := len(s.schema); l < 1 || l > MaxLengthCredentialSchema {
+		return util.ErrInvalid.Errorf("invalid length of credential schema, 0 < length <= %d", MaxLengthCredentialSchema)
+	}
+
+	if _, err := parseSchema([]byte(s.schema)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s CredentialSchema) Schema() string {
+	return s.schema
+}
+
+// Validate checks the credential value is json and satisfies the schema.
+func (s CredentialSchema) Validate(value string) error {
+	n, err := parseSchema([]byte(s.schema))
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	if err := decodeJSONValue([]byte(value), &v); err != nil {
+		return errors.Wrap(err, "credential value not json")
+	}
+
+	if err := n.validate(v, "$"); err != nil {
+		return errors.WithMessage(err, "credential value not match schema")
+	}
+
+	return nil
+}
+
+// ValidateClaim checks the disclosed claim with the property of the schema;
+// the claims of selective disclosure credential are checked one by one.
+func (s CredentialSchema) ValidateClaim(name string, value interface{}) error {
+	n, err := parseSchema([]byte(s.schema))
+	if err != nil {
+		return err
+	}
+
+	if err := n.validateProperty(name, value, "$"); err != nil {
+		return errors.WithMessage(err, "claim not match schema")
+	}
+
+	return nil
+}
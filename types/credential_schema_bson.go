@@ -0,0 +1,39 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s CredentialSchema) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":  s.Hint().String(),
+			"schema": s.schema,
+		},
+	)
+}
+
+type CredentialSchemaBSONUnmarshaler struct {
+	Hint   string `bson:"_hint"`
+	Schema string `bson:"schema"`
+}
+
+func (s *CredentialSchema) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of CredentialSchema")
+
+	var u CredentialSchemaBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Schema)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s *CredentialSchema) unpack(_ encoder.Encoder, ht hint.Hint, schema string) error {
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.schema = schema
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type CredentialSchemaJSONMarshaler struct {
+	hint.BaseHinter
+	Schema string `json:"schema"`
+}
+
+func (s CredentialSchema) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(CredentialSchemaJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Schema:     s.schema,
+	})
+}
+
+type CredentialSchemaJSONUnmarshaler struct {
+	Hint   hint.Hint `json:"_hint"`
+	Schema string    `json:"schema"`
+}
+
+func (s *CredentialSchema) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of CredentialSchema")
+
+	var u CredentialSchemaJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Schema)
+}
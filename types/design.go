@@ -9,13 +9,15 @@ var DesignHint = hint.MustNewHint("mitum-credential-design-v0.0.1")
 
 type Design struct {
 	hint.BaseHinter
-	policy Policy
+	policy     Policy
+	didMethods []string
 }
 
-func NewDesign(policy Policy) Design {
+func NewDesign(policy Policy, didMethods []string) Design {
 	return Design{
 		BaseHinter: hint.NewBaseHinter(DesignHint),
 		policy:     policy,
+		didMethods: didMethods,
 	}
 }
 
@@ -27,15 +29,40 @@ func (de Design) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("invalid Design: %v", err)
 	}
 
+	if err := IsValidDIDMethods(de.didMethods); err != nil {
+		return util.ErrInvalid.Errorf("invalid Design: %v", err)
+	}
+
 	return nil
 }
 
 func (de Design) Bytes() []byte {
 	return util.ConcatBytesSlice(
 		de.policy.Bytes(),
+		DIDMethodsBytes(de.didMethods),
 	)
 }
 
 func (de Design) Policy() Policy {
 	return de.policy
 }
+
+func (de Design) DIDMethods() []string {
+	return de.didMethods
+}
+
+// IsAllowedDIDMethod reports whether the did method is accepted by the
+// service. Every method is accepted when no did methods are set.
+func (de Design) IsAllowedDIDMethod(method string) bool {
+	if len(de.didMethods) < 1 {
+		return true
+	}
+
+	for _, m := range de.didMethods {
+		if m == method {
+			return true
+		}
+	}
+
+	return false
+}
@@ -9,17 +9,22 @@ import (
 )
 
 func (de Design) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":  de.Hint().String(),
-			"policy": de.policy,
-		},
-	)
+	m := bson.M{
+		"_hint":  de.Hint().String(),
+		"policy": de.policy,
+	}
+
+	if len(de.didMethods) > 0 {
+		m["did_methods"] = de.didMethods
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type DesignBSONUnmarshaler struct {
-	Hint   string   `bson:"_hint"`
-	Policy bson.Raw `bson:"policy"`
+	Hint       string   `bson:"_hint"`
+	Policy     bson.Raw `bson:"policy"`
+	DIDMethods []string `bson:"did_methods"`
 }
 
 func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -35,5 +40,5 @@ func (de *Design) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ht, ud.Policy)
+	return de.unpack(enc, ht, ud.Policy, ud.DIDMethods)
 }
@@ -7,7 +7,7 @@ import (
 	"github.com/pkg/errors"
 )
 
-func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, bPcy []byte) error {
+func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, bPcy []byte, didMethods []string) error {
 	e := util.StringError("failed to unpack of Design")
 
 	de.BaseHinter = hint.NewBaseHinter(ht)
@@ -19,6 +19,9 @@ func (de *Design) unpack(enc encoder.Encoder, ht hint.Hint, bPcy []byte) error {
 	} else {
 		de.policy = po
 	}
+
+	de.didMethods = didMethods
+
 	if err := de.IsValid(nil); err != nil {
 		return e.Wrap(err)
 	}
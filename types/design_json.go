@@ -10,19 +10,22 @@ import (
 
 type DesignJSONMarshaler struct {
 	hint.BaseHinter
-	Policy Policy `json:"policy"`
+	Policy     Policy   `json:"policy"`
+	DIDMethods []string `json:"did_methods,omitempty"`
 }
 
 func (de Design) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(DesignJSONMarshaler{
 		BaseHinter: de.BaseHinter,
 		Policy:     de.policy,
+		DIDMethods: de.didMethods,
 	})
 }
 
 type DesignJSONUnmarshaler struct {
-	Hint   hint.Hint       `json:"_hint"`
-	Policy json.RawMessage `json:"policy"`
+	Hint       hint.Hint       `json:"_hint"`
+	Policy     json.RawMessage `json:"policy"`
+	DIDMethods []string        `json:"did_methods"`
 }
 
 func (de *Design) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -33,5 +36,5 @@ func (de *Design) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return de.unpack(enc, ud.Hint, ud.Policy)
+	return de.unpack(enc, ud.Hint, ud.Policy, ud.DIDMethods)
 }
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var (
+	// REDIDString follows the did syntax of DID Core,
+	// did = "did:" method-name ":" method-specific-id.
+	REDIDString        = `^did:([a-z0-9]+):((?:(?:[A-Za-z0-9._\-]|%[0-9A-Fa-f]{2})*:)*(?:[A-Za-z0-9._\-]|%[0-9A-Fa-f]{2})+)$`
+	REDIDExp           = regexp.MustCompile(REDIDString)
+	REDIDMethodExp     = regexp.MustCompile(`^[a-z0-9]+$`)
+	MaxLengthDID       = 1024
+	MaxDIDMethods      = 20
+	MaxLengthDIDMethod = 50
+)
+
+type DID string
+
+func (d DID) Bytes() []byte {
+	return []byte(d)
+}
+
+func (d DID) String() string {
+	return string(d)
+}
+
+func (d DID) IsValid([]byte) error {
+	if len(d) == 0 {
+		return util.ErrInvalid.Errorf("empty did")
+	}
+
+	if len(d) > MaxLengthDID {
+		return util.ErrInvalid.Errorf("too long did, %d > %d", len(d), MaxLengthDID)
+	}
+
+	if !REDIDExp.MatchString(string(d)) {
+		return util.ErrInvalid.Errorf("wrong did, %q", d)
+	}
+
+	return nil
+}
+
+func (d DID) Method() string {
+	m := REDIDExp.FindStringSubmatch(string(d))
+	if len(m) < 3 {
+		return ""
+	}
+
+	return m[1]
+}
+
+func (d DID) MethodSpecificID() string {
+	m := REDIDExp.FindStringSubmatch(string(d))
+	if len(m) < 3 {
+		return ""
+	}
+
+	return m[2]
+}
+
+func ParseDID(s string) (DID, error) {
+	d := DID(s)
+	if err := d.IsValid(nil); err != nil {
+		return "", err
+	}
+
+	return d, nil
+}
+
+func IsValidDIDMethods(methods []string) error {
+	if len(methods) > MaxDIDMethods {
+		return util.ErrInvalid.Errorf("too many did methods, %d > %d", len(methods), MaxDIDMethods)
+	}
+
+	founds := map[string]struct{}{}
+	for _, m := range methods {
+		if len(m) > MaxLengthDIDMethod || !REDIDMethodExp.MatchString(m) {
+			return util.ErrInvalid.Errorf("wrong did method, %q", m)
+		}
+
+		if _, found := founds[m]; found {
+			return util.ErrInvalid.Errorf("duplicated did method, %q", m)
+		}
+
+		founds[m] = struct{}{}
+	}
+
+	return nil
+}
+
+func DIDMethodsBytes(methods []string) []byte {
+	return []byte(strings.Join(methods, ","))
+}
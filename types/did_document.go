@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+)
+
+var (
+	DIDContextV1                 = "https://www.w3.org/ns/did/v1"
+	Secp256k1VerificationContext = "https://w3id.org/security/suites/secp256k1-2019/v1"
+	Secp256k1VerificationKeyType = "EcdsaSecp256k1VerificationKey2019"
+)
+
+// AccountDID returns the did:mitum DID of the account in the network.
+func AccountDID(network, address string) string {
+	return fmt.Sprintf("did:%s:%s:%s", DIDMethod, network, address)
+}
+
+// ParseAccountDID returns the network and the address of the did:mitum DID,
+// did:mitum:<network>:<address>. The network is empty for the DID without
+// network, did:mitum:<address>.
+func ParseAccountDID(d DID) (string, string, error) {
+	if err := d.IsValid(nil); err != nil {
+		return "", "", err
+	}
+
+	if m := d.Method(); m != DIDMethod {
+		return "", "", util.ErrInvalid.Errorf("not %s did method, %q", DIDMethod, m)
+	}
+
+	id := d.MethodSpecificID()
+
+	i := strings.LastIndex(id, ":")
+	if i < 0 {
+		return "", id, nil
+	}
+
+	return id[:i], id[i+1:], nil
+}
+
+type DIDVerificationMethod struct {
+	ID              string `json:"id"`
+	Type            string `json:"type"`
+	Controller      string `json:"controller"`
+	PublicKeyBase58 string `json:"publicKeyBase58"`
+	Weight          uint   `json:"weight"`
+}
+
+// DIDDocument is the DID Core document of an account. Each account key is a
+// verification method; a proof is valid when the sum of the weights of its
+// verification methods reaches threshold.
+type DIDDocument struct {
+	Context            []string                `json:"@context"`
+	ID                 string                  `json:"id"`
+	Controller         string                  `json:"controller"`
+	VerificationMethod []DIDVerificationMethod `json:"verificationMethod"`
+	Authentication     []string                `json:"authentication"`
+	AssertionMethod    []string                `json:"assertionMethod"`
+	Threshold          uint                    `json:"threshold"`
+}
+
+func NewDIDDocument(did string, keys currencytypes.AccountKeys) DIDDocument {
+	doc := DIDDocument{
+		Context:            []string{DIDContextV1, Secp256k1VerificationContext},
+		ID:                 did,
+		Controller:         did,
+		VerificationMethod: []DIDVerificationMethod{},
+		Authentication:     []string{},
+		AssertionMethod:    []string{},
+	}
+
+	if keys == nil {
+		return doc
+	}
+
+	doc.Threshold = keys.Threshold()
+
+	for i, k := range keys.Keys() {
+		id := did + "#key-" + strconv.Itoa(i+1)
+
+		doc.VerificationMethod = append(doc.VerificationMethod, DIDVerificationMethod{
+			ID:              id,
+			Type:            Secp256k1VerificationKeyType,
+			Controller:      did,
+			PublicKeyBase58: strings.TrimSuffix(k.Key().String(), base.MPublickeyHint.Type().String()),
+			Weight:          k.Weight(),
+		})
+		doc.Authentication = append(doc.Authentication, id)
+		doc.AssertionMethod = append(doc.AssertionMethod, id)
+	}
+
+	return doc
+}
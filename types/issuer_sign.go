@@ -0,0 +1,91 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var IssuerSignHint = hint.MustNewHint("mitum-credential-issuer-sign-v0.0.1")
+
+// IssuerSign is the signature of issuer over the credential of the contract;
+// it is verified with the network id and without the chain state.
+type IssuerSign struct {
+	hint.BaseHinter
+	signer    base.Publickey
+	signature base.Signature
+	signedAt  time.Time
+}
+
+func NewIssuerSign(signer base.Publickey, signature base.Signature, signedAt time.Time) IssuerSign {
+	return IssuerSign{
+		BaseHinter: hint.NewBaseHinter(IssuerSignHint),
+		signer:     signer,
+		signature:  signature,
+		signedAt:   signedAt,
+	}
+}
+
+// NewIssuerSignFromCredential signs the credential of the contract by the
+// key of issuer.
+func NewIssuerSignFromCredential(
+	priv base.Privatekey, networkID base.NetworkID, contract base.Address, credential Credential,
+) (IssuerSign, error) {
+	sign, err := base.NewBaseSignFromBytes(priv, networkID, IssuerSignBytes(contract, credential))
+	if err != nil {
+		return IssuerSign{}, err
+	}
+
+	return NewIssuerSign(sign.Signer(), sign.Signature(), sign.SignedAt()), nil
+}
+
+// IssuerSignBytes returns the bytes signed by issuer; the credential is bound
+// to the contract, so that the signature can not be used for the other
+// credential service.
+func IssuerSignBytes(contract base.Address, credential Credential) []byte {
+	var c []byte
+	if contract != nil {
+		c = contract.Bytes()
+	}
+
+	return lengthPrefixedBytes(
+		IssuerSignHint.Type().Bytes(),
+		c,
+		credential.CanonicalBytes(),
+	)
+}
+
+func (s IssuerSign) Bytes() []byte {
+	return s.sign().Bytes()
+}
+
+func (s IssuerSign) IsValid([]byte) error {
+	if err := s.BaseHinter.IsValid(IssuerSignHint.Type().Bytes()); err != nil {
+		return util.ErrInvalid.Wrap(err)
+	}
+
+	return s.sign().IsValid(nil)
+}
+
+// Verify checks the signature over the credential of the contract.
+func (s IssuerSign) Verify(networkID base.NetworkID, contract base.Address, credential Credential) error {
+	return s.sign().Verify(networkID, IssuerSignBytes(contract, credential))
+}
+
+func (s IssuerSign) Signer() base.Publickey {
+	return s.signer
+}
+
+func (s IssuerSign) Signature() base.Signature {
+	return s.signature
+}
+
+func (s IssuerSign) SignedAt() time.Time {
+	return s.signedAt
+}
+
+func (s IssuerSign) sign() base.BaseSign {
+	return base.NewBaseSign(s.signer, s.signature, s.signedAt)
+}
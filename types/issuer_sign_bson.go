@@ -0,0 +1,55 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+)
+
+func (s IssuerSign) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     s.Hint().String(),
+			"signer":    s.signer.String(),
+			"signature": s.signature.String(),
+			"signed_at": localtime.New(s.signedAt).Normalize().RFC3339(),
+		},
+	)
+}
+
+type IssuerSignBSONUnmarshaler struct {
+	Hint      string `bson:"_hint"`
+	Signer    string `bson:"signer"`
+	Signature string `bson:"signature"`
+	SignedAt  string `bson:"signed_at"`
+}
+
+func (s *IssuerSign) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of IssuerSign")
+
+	var u IssuerSignBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	var signature base.Signature
+	if err := signature.UnmarshalText([]byte(u.Signature)); err != nil {
+		return e.Wrap(err)
+	}
+
+	var signedAt localtime.Time
+	if err := signedAt.UnmarshalText([]byte(u.SignedAt)); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Signer, signature, signedAt)
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+)
+
+func (s *IssuerSign) unpack(
+	enc encoder.Encoder, ht hint.Hint, signer string, signature base.Signature, signedAt localtime.Time,
+) error {
+	e := util.StringError("failed to unpack of IssuerSign")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.signature = signature
+	s.signedAt = signedAt.Time
+
+	switch k, err := base.DecodePublickeyFromString(signer, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		s.signer = k
+	}
+
+	return nil
+}
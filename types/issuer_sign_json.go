@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+	"github.com/ProtoconNet/mitum2/util/localtime"
+)
+
+type IssuerSignJSONMarshaler struct {
+	hint.BaseHinter
+	Signer    base.Publickey `json:"signer"`
+	Signature base.Signature `json:"signature"`
+	SignedAt  localtime.Time `json:"signed_at"`
+}
+
+func (s IssuerSign) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(IssuerSignJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Signer:     s.signer,
+		Signature:  s.signature,
+		SignedAt:   localtime.New(s.signedAt),
+	})
+}
+
+type IssuerSignJSONUnmarshaler struct {
+	Hint      hint.Hint      `json:"_hint"`
+	Signer    string         `json:"signer"`
+	Signature base.Signature `json:"signature"`
+	SignedAt  localtime.Time `json:"signed_at"`
+}
+
+func (s *IssuerSign) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of IssuerSign")
+
+	var u IssuerSignJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Signer, u.Signature, u.SignedAt)
+}
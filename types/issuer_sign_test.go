@@ -0,0 +1,129 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	currencytypes "github.com/ProtoconNet/mitum-currency/v3/types"
+	"github.com/ProtoconNet/mitum2/base"
+)
+
+func TestCredentialCanonicalBytes(t *testing.T) {
+	holder := currencytypes.NewAddress("holder")
+	c := NewCredential(holder, "template", "id", "value", 1, 2, "did")
+
+	cases := []struct {
+		name string
+		c    Credential
+	}{
+		{name: "other holder", c: NewCredential(currencytypes.NewAddress("holder0"), "template", "id", "value", 1, 2, "did")},
+		{name: "no holder", c: NewCredential(nil, "template", "id", "value", 1, 2, "did")},
+		{name: "other value", c: NewCredential(holder, "template", "id", "value0", 1, 2, "did")},
+		{name: "other valid from", c: NewCredential(holder, "template", "id", "value", 0, 2, "did")},
+		{name: "other valid until", c: NewCredential(holder, "template", "id", "value", 1, 3, "did")},
+		{name: "other did", c: NewCredential(holder, "template", "id", "value", 1, 2, "did0")},
+		// Bytes of the concatenated fields is same for them; the canonical
+		// bytes are length prefixed.
+		{name: "moved boundary of id and value", c: NewCredential(holder, "template", "idv", "alue", 1, 2, "did")},
+		{name: "moved boundary of template and id", c: NewCredential(holder, "templat", "eid", "value", 1, 2, "did")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if bytes.Equal(c.CanonicalBytes(), tc.c.CanonicalBytes()) {
+				t.Fatal("same canonical bytes")
+			}
+		})
+	}
+}
+
+func TestIssuerSign(t *testing.T) {
+	networkID := base.NetworkID("test")
+	priv := base.NewMPrivatekey()
+	contract := currencytypes.NewAddress("contract")
+	holder := currencytypes.NewAddress("holder")
+	c := NewCredential(holder, "template", "id", "value", 1, 2, "did")
+
+	sign, err := NewIssuerSignFromCredential(priv, networkID, contract, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := sign.IsValid(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if !sign.Signer().Equal(priv.Publickey()) {
+		t.Fatal("signer not issuer")
+	}
+
+	if err := sign.Verify(networkID, contract, c); err != nil {
+		t.Fatal(err)
+	}
+
+	// the signature is over the canonical bytes with the contract.
+	if err := base.NewBaseSign(sign.Signer(), sign.Signature(), sign.SignedAt()).Verify(
+		networkID, lengthPrefixedBytes(IssuerSignHint.Type().Bytes(), contract.Bytes(), c.CanonicalBytes()),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	other, err := NewIssuerSignFromCredential(base.NewMPrivatekey(), networkID, contract, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		name       string
+		sign       IssuerSign
+		networkID  base.NetworkID
+		contract   base.Address
+		credential Credential
+	}{
+		{
+			name: "other network", sign: sign, networkID: base.NetworkID("test0"),
+			contract: contract, credential: c,
+		},
+		{
+			name: "other contract", sign: sign, networkID: networkID,
+			contract: currencytypes.NewAddress("contract0"), credential: c,
+		},
+		{
+			name: "no contract", sign: sign, networkID: networkID,
+			contract: nil, credential: c,
+		},
+		{
+			name: "other value", sign: sign, networkID: networkID, contract: contract,
+			credential: NewCredential(holder, "template", "id", "value0", 1, 2, "did"),
+		},
+		{
+			name: "other holder", sign: sign, networkID: networkID, contract: contract,
+			credential: NewCredential(currencytypes.NewAddress("holder0"), "template", "id", "value", 1, 2, "did"),
+		},
+		{
+			name: "other valid until", sign: sign, networkID: networkID, contract: contract,
+			credential: NewCredential(holder, "template", "id", "value", 1, 3, "did"),
+		},
+		{
+			name: "moved boundary", sign: sign, networkID: networkID, contract: contract,
+			credential: NewCredential(holder, "template", "idv", "alue", 1, 2, "did"),
+		},
+		{
+			name: "signature of other key", networkID: networkID, contract: contract, credential: c,
+			sign: NewIssuerSign(sign.Signer(), other.Signature(), other.SignedAt()),
+		},
+		{
+			name: "other signed at", networkID: networkID, contract: contract, credential: c,
+			sign: NewIssuerSign(sign.Signer(), sign.Signature(), sign.SignedAt().Add(time.Second)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.sign.Verify(tc.networkID, tc.contract, tc.credential); err == nil {
+				t.Fatal("invalid issuer sign verified")
+			}
+		})
+	}
+}
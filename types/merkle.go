@@ -0,0 +1,180 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// The merkle tree of the credential batch follows RFC 9162; the leaf and the
+// node hashes are prefixed with different bytes, and the last node of the odd
+// level is promoted without hashing.
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+func MerkleLeafHash(b []byte) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte{merkleLeafPrefix})
+	_, _ = h.Write(b)
+
+	return h.Sum(nil)
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	_, _ = h.Write([]byte{merkleNodePrefix})
+	_, _ = h.Write(left)
+	_, _ = h.Write(right)
+
+	return h.Sum(nil)
+}
+
+// CredentialLeaf is the merkle leaf of credential.
+func CredentialLeaf(c Credential) []byte {
+	return c.CanonicalBytes()
+}
+
+// MerkleTree keeps every level of the tree, so that the proofs of all the
+// leaves of a large batch are built without hashing the tree again.
+type MerkleTree struct {
+	levels [][][]byte
+}
+
+func NewMerkleTree(leaves [][]byte) (MerkleTree, error) {
+	if len(leaves) < 1 {
+		return MerkleTree{}, errors.Errorf("empty leaves")
+	}
+
+	level := make([][]byte, len(leaves))
+	for i := range leaves {
+		level[i] = MerkleLeafHash(leaves[i])
+	}
+
+	levels := [][][]byte{level}
+
+	for len(level) > 1 {
+		next := make([][]byte, (len(level)+1)/2)
+		for i := range next {
+			if j := i * 2; j+1 < len(level) {
+				next[i] = merkleNodeHash(level[j], level[j+1])
+			} else {
+				next[i] = level[j]
+			}
+		}
+
+		levels = append(levels, next)
+		level = next
+	}
+
+	return MerkleTree{levels: levels}, nil
+}
+
+func (t MerkleTree) Root() []byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+func (t MerkleTree) Count() uint64 {
+	return uint64(len(t.levels[0]))
+}
+
+// Proof returns the sibling hashes from the leaf of index to the root.
+func (t MerkleTree) Proof(index uint64) ([][]byte, error) {
+	if index >= t.Count() {
+		return nil, errors.Errorf("index out of leaves, %d >= %d", index, t.Count())
+	}
+
+	var proof [][]byte
+
+	i := index
+	for _, level := range t.levels[:len(t.levels)-1] {
+		if s := i ^ 1; s < uint64(len(level)) {
+			proof = append(proof, level[s])
+		}
+
+		i >>= 1
+	}
+
+	return proof, nil
+}
+
+// VerifyMerkleProof checks the leaf of index is included in the tree of
+// count leaves with root, as the inclusion proof verification of RFC 9162.
+func VerifyMerkleProof(root, leaf []byte, index, count uint64, proof [][]byte) bool {
+	if index >= count {
+		return false
+	}
+
+	fn, sn := index, count-1
+	r := MerkleLeafHash(leaf)
+
+	for _, p := range proof {
+		if sn == 0 {
+			return false
+		}
+
+		if fn&1 == 1 || fn == sn {
+			r = merkleNodeHash(p, r)
+
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = merkleNodeHash(r, p)
+		}
+
+		fn >>= 1
+		sn >>= 1
+	}
+
+	return sn == 0 && bytes.Equal(r, root)
+}
+
+// IsValidMerkleRoot checks the hex encoded root.
+func IsValidMerkleRoot(root string) error {
+	b, err := hex.DecodeString(root)
+
+	switch {
+	case err != nil:
+		return util.ErrInvalid.WithMessage(err, "merkle root not hex")
+	case len(b) != sha256.Size:
+		return util.ErrInvalid.Errorf("invalid length of merkle root, %d != %d", len(b), sha256.Size)
+	case hex.EncodeToString(b) != root:
+		return util.ErrInvalid.Errorf("merkle root not lowercase hex")
+	default:
+		return nil
+	}
+}
+
+// EncodeMerkleProof encodes the proof hashes to hex strings.
+func EncodeMerkleProof(proof [][]byte) []string {
+	s := make([]string, len(proof))
+	for i := range proof {
+		s[i] = hex.EncodeToString(proof[i])
+	}
+
+	return s
+}
+
+func DecodeMerkleProof(s []string) ([][]byte, error) {
+	proof := make([][]byte, len(s))
+	for i := range s {
+		b, err := hex.DecodeString(s[i])
+		if err != nil {
+			return nil, util.ErrInvalid.WithMessage(err, "merkle proof not hex, %d", i)
+		}
+
+		if len(b) != sha256.Size {
+			return nil, util.ErrInvalid.Errorf("invalid length of merkle proof, %d; %d != %d", i, len(b), sha256.Size)
+		}
+
+		proof[i] = b
+	}
+
+	return proof, nil
+}
@@ -0,0 +1,263 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+)
+
+// rfc9162Root is MTH of RFC 9162 section 2.1.1, built recursively with the
+// largest power of two smaller than the count of leaves as the split.
+func rfc9162Root(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return MerkleLeafHash(leaves[0])
+	}
+
+	k := 1
+	for k*2 < len(leaves) {
+		k *= 2
+	}
+
+	return merkleNodeHash(rfc9162Root(leaves[:k]), rfc9162Root(leaves[k:]))
+}
+
+// rfc9162Path is PATH of RFC 9162 section 2.1.3.1.
+func rfc9162Path(index int, leaves [][]byte) [][]byte {
+	if len(leaves) == 1 {
+		return nil
+	}
+
+	k := 1
+	for k*2 < len(leaves) {
+		k *= 2
+	}
+
+	if index < k {
+		return append(rfc9162Path(index, leaves[:k]), rfc9162Root(leaves[k:]))
+	}
+
+	return append(rfc9162Path(index-k, leaves[k:]), rfc9162Root(leaves[:k]))
+}
+
+func testMerkleLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = []byte(fmt.Sprintf("leaf-%d", i))
+	}
+
+	return leaves
+}
+
+func TestMerkleTreeRoot(t *testing.T) {
+	a, b, c := []byte("a"), []byte("b"), []byte("c")
+
+	cases := []struct {
+		name   string
+		leaves [][]byte
+		root   []byte
+	}{
+		{name: "one leaf", leaves: [][]byte{a}, root: MerkleLeafHash(a)},
+		{
+			name:   "two leaves",
+			leaves: [][]byte{a, b},
+			root:   merkleNodeHash(MerkleLeafHash(a), MerkleLeafHash(b)),
+		},
+		{
+			name:   "three leaves; last leaf promoted",
+			leaves: [][]byte{a, b, c},
+			root:   merkleNodeHash(merkleNodeHash(MerkleLeafHash(a), MerkleLeafHash(b)), MerkleLeafHash(c)),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tree, err := NewMerkleTree(tc.leaves)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !bytes.Equal(tree.Root(), tc.root) {
+				t.Fatalf("root mismatch, %x != %x", tree.Root(), tc.root)
+			}
+		})
+	}
+
+	for n := 1; n <= 70; n++ {
+		leaves := testMerkleLeaves(n)
+
+		tree, err := NewMerkleTree(leaves)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(tree.Root(), rfc9162Root(leaves)) {
+			t.Fatalf("root not RFC 9162 MTH, n=%d", n)
+		}
+	}
+}
+
+func TestMerkleTreeEmpty(t *testing.T) {
+	if _, err := NewMerkleTree(nil); err == nil {
+		t.Fatal("empty leaves accepted")
+	}
+}
+
+func TestMerkleLeafAndNodePrefix(t *testing.T) {
+	a, b := []byte("a"), []byte("b")
+
+	// the node of two leaves must not be the hash of leaf of their
+	// concatenation, the second preimage attack RFC 9162 prevents.
+	node := merkleNodeHash(MerkleLeafHash(a), MerkleLeafHash(b))
+	leaf := MerkleLeafHash(append(MerkleLeafHash(a), MerkleLeafHash(b)...))
+
+	if bytes.Equal(node, leaf) {
+		t.Fatal("leaf and node hash not separated")
+	}
+
+	if h := MerkleLeafHash(a); len(h) != sha256.Size {
+		t.Fatalf("invalid leaf hash length, %d", len(h))
+	}
+}
+
+func TestMerkleInclusionProof(t *testing.T) {
+	for n := 1; n <= 70; n++ {
+		leaves := testMerkleLeaves(n)
+
+		tree, err := NewMerkleTree(leaves)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i := range leaves {
+			proof, err := tree.Proof(uint64(i))
+			if err != nil {
+				t.Fatalf("n=%d, i=%d; %v", n, i, err)
+			}
+
+			expected := rfc9162Path(i, leaves)
+			if len(proof) != len(expected) {
+				t.Fatalf("proof length not RFC 9162 PATH, n=%d, i=%d; %d != %d", n, i, len(proof), len(expected))
+			}
+
+			for j := range proof {
+				if !bytes.Equal(proof[j], expected[j]) {
+					t.Fatalf("proof not RFC 9162 PATH, n=%d, i=%d, j=%d", n, i, j)
+				}
+			}
+
+			if !VerifyMerkleProof(tree.Root(), leaves[i], uint64(i), uint64(n), proof) {
+				t.Fatalf("valid proof rejected, n=%d, i=%d", n, i)
+			}
+		}
+
+		if _, err := tree.Proof(uint64(n)); err == nil {
+			t.Fatalf("proof of out of leaves index, n=%d", n)
+		}
+	}
+}
+
+func TestMerkleInclusionProofRejected(t *testing.T) {
+	const n = 13
+
+	leaves := testMerkleLeaves(n)
+
+	tree, err := NewMerkleTree(leaves)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const index = 6
+
+	proof, err := tree.Proof(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tampered := make([][]byte, len(proof))
+	for i := range proof {
+		tampered[i] = append([]byte(nil), proof[i]...)
+	}
+	tampered[1][0] ^= 0xff
+
+	other := make([]byte, sha256.Size)
+
+	cases := []struct {
+		name  string
+		root  []byte
+		leaf  []byte
+		index uint64
+		count uint64
+		proof [][]byte
+	}{
+		{name: "wrong leaf", root: tree.Root(), leaf: leaves[index+1], index: index, count: n, proof: proof},
+		{name: "wrong index", root: tree.Root(), leaf: leaves[index], index: index + 1, count: n, proof: proof},
+		// the count of the same path of the leaf, like 12 or 16, is accepted
+		// as RFC 9162; the root binds the count.
+		{name: "wrong count", root: tree.Root(), leaf: leaves[index], index: index, count: index + 1, proof: proof},
+		{name: "index out of count", root: tree.Root(), leaf: leaves[index], index: n, count: n, proof: proof},
+		{name: "wrong root", root: other, leaf: leaves[index], index: index, count: n, proof: proof},
+		{name: "tampered proof", root: tree.Root(), leaf: leaves[index], index: index, count: n, proof: tampered},
+		{name: "short proof", root: tree.Root(), leaf: leaves[index], index: index, count: n, proof: proof[:len(proof)-1]},
+		{name: "long proof", root: tree.Root(), leaf: leaves[index], index: index, count: n, proof: append(append([][]byte{}, proof...), other)},
+		{name: "empty proof", root: tree.Root(), leaf: leaves[index], index: index, count: n, proof: nil},
+		{
+			name: "leaf hash as leaf", root: tree.Root(), leaf: MerkleLeafHash(leaves[index]),
+			index: index, count: n, proof: proof,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if VerifyMerkleProof(tc.root, tc.leaf, tc.index, tc.count, tc.proof) {
+				t.Fatal("invalid proof accepted")
+			}
+		})
+	}
+}
+
+func TestMerkleProofEncoding(t *testing.T) {
+	tree, err := NewMerkleTree(testMerkleLeaves(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	proof, err := tree.Proof(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	decoded, err := DecodeMerkleProof(EncodeMerkleProof(proof))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !VerifyMerkleProof(tree.Root(), []byte("leaf-4"), 4, 5, decoded) {
+		t.Fatal("decoded proof rejected")
+	}
+
+	for _, s := range []string{"zz", "00"} {
+		if _, err := DecodeMerkleProof([]string{s}); err == nil {
+			t.Fatalf("invalid proof decoded, %q", s)
+		}
+	}
+
+	cases := []struct {
+		name  string
+		root  string
+		valid bool
+	}{
+		{name: "valid", root: fmt.Sprintf("%x", tree.Root()), valid: true},
+		{name: "upper case", root: fmt.Sprintf("%X", tree.Root())},
+		{name: "short", root: "00"},
+		{name: "not hex", root: "zz"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := IsValidMerkleRoot(tc.root); (err == nil) != tc.valid {
+				t.Fatalf("valid=%v, err=%v", tc.valid, err)
+			}
+		})
+	}
+}
@@ -8,6 +8,9 @@ import (
 
 var HolderHint = hint.MustNewHint("mitum-credential-holder-v0.0.1")
 
+// Holder is the per-holder entry of the legacy Policy holders list. It is
+// kept only to decode designs written before holder counts were moved to
+// their own states.
 type Holder struct {
 	hint.BaseHinter
 	address         base.Address
@@ -47,15 +50,20 @@ type Policy struct {
 	hint.BaseHinter
 	templateIDs     []string
 	holders         []Holder
+	templateCount   uint64
 	credentialCount uint64
+	holderCount     uint64
 }
 
-func NewPolicy(templates []string, holders []Holder, credentialCount uint64) Policy {
+// NewPolicy creates the policy with the counts; legacyHolders is the holders
+// list of the previous policy, which is kept until every holder is migrated.
+func NewPolicy(templateCount, credentialCount, holderCount uint64, legacyHolders []Holder) Policy {
 	return Policy{
 		BaseHinter:      hint.NewBaseHinter(PolicyHint),
-		templateIDs:     templates,
-		holders:         holders,
+		holders:         legacyHolders,
+		templateCount:   templateCount,
 		credentialCount: credentialCount,
+		holderCount:     holderCount,
 	}
 }
 
@@ -70,13 +78,45 @@ func (po Policy) Bytes() []byte {
 		hs[i] = h.Bytes()
 	}
 
+	if po.isLegacy() {
+		return util.ConcatBytesSlice(
+			util.ConcatBytesSlice(ts...),
+			util.ConcatBytesSlice(hs...),
+			util.Uint64ToBytes(po.credentialCount),
+		)
+	}
+
 	return util.ConcatBytesSlice(
 		util.ConcatBytesSlice(ts...),
 		util.ConcatBytesSlice(hs...),
+		util.Uint64ToBytes(po.templateCount),
 		util.Uint64ToBytes(po.credentialCount),
+		util.Uint64ToBytes(po.holderCount),
 	)
 }
 
+// isLegacy reports whether the template and holder counts are the ones
+// derived from the legacy lists, so Bytes keeps the legacy layout and the
+// hashes of the designs written before the counts do not change.
+func (po Policy) isLegacy() bool {
+	if po.templateCount != uint64(len(po.templateIDs)) {
+		return false
+	}
+
+	return po.holderCount == legacyHolderCount(po.holders)
+}
+
+func legacyHolderCount(holders []Holder) uint64 {
+	var n uint64
+	for _, h := range holders {
+		if h.CredentialCount() > 0 {
+			n++
+		}
+	}
+
+	return n
+}
+
 func (po Policy) IsValid([]byte) error {
 	e := util.StringError("invalid policy")
 
@@ -93,14 +133,28 @@ func (po Policy) IsValid([]byte) error {
 	return nil
 }
 
-func (po Policy) TemplateIDs() []string {
+// LegacyTemplateIDs returns the template ids list of a design written before
+// templates were looked up by their own states. New policies never carry it.
+func (po Policy) LegacyTemplateIDs() []string {
 	return po.templateIDs
 }
 
-func (po Policy) Holders() []Holder {
+func (po Policy) TemplateCount() uint64 {
+	return po.templateCount
+}
+
+// LegacyHolders returns the holders list of a design written before holder
+// counts were moved to holder stat states. The list is not updated; each
+// holder is migrated to its holder stat state when it is touched, and the
+// holder stat state is used after.
+func (po Policy) LegacyHolders() []Holder {
 	return po.holders
 }
 
 func (po Policy) CredentialCount() uint64 {
 	return po.credentialCount
 }
+
+func (po Policy) HolderCount() uint64 {
+	return po.holderCount
+}
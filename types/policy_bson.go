@@ -41,21 +41,31 @@ func (h *Holder) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 }
 
 func (po Policy) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":            po.Hint().String(),
-			"templates":        po.templateIDs,
-			"holders":          po.holders,
-			"credential_count": po.credentialCount,
-		},
-	)
+	m := bson.M{
+		"_hint":            po.Hint().String(),
+		"template_count":   po.templateCount,
+		"credential_count": po.credentialCount,
+		"holder_count":     po.holderCount,
+	}
+
+	if len(po.templateIDs) > 0 {
+		m["templates"] = po.templateIDs
+	}
+
+	if len(po.holders) > 0 {
+		m["holders"] = po.holders
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type PolicyBSONUnmarshaler struct {
 	Hint            string   `bson:"_hint"`
 	Templates       []string `bson:"templates"`
 	Holders         bson.Raw `bson:"holders"`
+	TemplateCount   uint64   `bson:"template_count"`
 	CredentialCount uint64   `bson:"credential_count"`
+	HolderCount     uint64   `bson:"holder_count"`
 }
 
 func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -71,5 +81,5 @@ func (po *Policy) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, ht, upo.Templates, upo.Holders, upo.CredentialCount)
+	return po.unpack(enc, ht, upo.Templates, upo.Holders, upo.TemplateCount, upo.CredentialCount, upo.HolderCount)
 }
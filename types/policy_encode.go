@@ -32,7 +32,7 @@ func (h *Holder) unpack(enc encoder.Encoder, ht hint.Hint, adr string, count uin
 	return nil
 }
 
-func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, tmplIDs []string, bHolders []byte, count uint64) error {
+func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, tmplIDs []string, bHolders []byte, templateCount, credentialCount, holderCount uint64) error {
 	e := util.StringError("failed to unpack of Policy")
 
 	po.BaseHinter = hint.NewBaseHinter(ht)
@@ -53,7 +53,18 @@ func (po *Policy) unpack(enc encoder.Encoder, ht hint.Hint, tmplIDs []string, bH
 		holders[i] = j
 	}
 	po.holders = holders
-	po.credentialCount = count
+	po.templateCount = templateCount
+	po.credentialCount = credentialCount
+	po.holderCount = holderCount
+
+	if po.templateCount < 1 {
+		po.templateCount = uint64(len(po.templateIDs))
+	}
+
+	if po.holderCount < 1 {
+		po.holderCount = legacyHolderCount(po.holders)
+	}
+
 	if err := po.IsValid(nil); err != nil {
 		return e.Wrap(err)
 	}
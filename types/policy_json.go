@@ -41,9 +41,11 @@ func (h *Holder) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 
 type PolicyJSONMarshaler struct {
 	hint.BaseHinter
-	Templates       []string `json:"templates"`
-	Holders         []Holder `json:"holders"`
+	Templates       []string `json:"templates,omitempty"`
+	Holders         []Holder `json:"holders,omitempty"`
+	TemplateCount   uint64   `json:"template_count"`
 	CredentialCount uint64   `json:"credential_count"`
+	HolderCount     uint64   `json:"holder_count"`
 }
 
 func (po Policy) MarshalJSON() ([]byte, error) {
@@ -51,7 +53,9 @@ func (po Policy) MarshalJSON() ([]byte, error) {
 		BaseHinter:      po.BaseHinter,
 		Templates:       po.templateIDs,
 		Holders:         po.holders,
+		TemplateCount:   po.templateCount,
 		CredentialCount: po.credentialCount,
+		HolderCount:     po.holderCount,
 	})
 }
 
@@ -59,7 +63,9 @@ type PolicyJSONUnmarshaler struct {
 	Hint            hint.Hint       `json:"_hint"`
 	Templates       []string        `json:"templates"`
 	Holders         json.RawMessage `json:"holders"`
+	TemplateCount   uint64          `json:"template_count"`
 	CredentialCount uint64          `json:"credential_count"`
+	HolderCount     uint64          `json:"holder_count"`
 }
 
 func (po *Policy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -70,5 +76,5 @@ func (po *Policy) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
-	return po.unpack(enc, upo.Hint, upo.Templates, upo.Holders, upo.CredentialCount)
+	return po.unpack(enc, upo.Hint, upo.Templates, upo.Holders, upo.TemplateCount, upo.CredentialCount, upo.HolderCount)
 }
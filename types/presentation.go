@@ -0,0 +1,48 @@
+package types
+
+var (
+	presentationBytesPrefix = []byte("mitum-credential-presentation")
+	disclosureBytesPrefix   = []byte("mitum-credential-disclosure")
+)
+
+// CredentialRef refers the credential of the contract presented by holder.
+type CredentialRef struct {
+	Contract   string `json:"contract"`
+	TemplateID string `json:"template_id"`
+	ID         string `json:"id"`
+}
+
+// PresentationBytes returns the bytes signed by holder for the presentation of
+// the credentials; the challenge of relying party is included, so that the
+// presentation can not be replayed to the other relying party.
+func PresentationBytes(holder string, refs []CredentialRef, challenge string) []byte {
+	bs := make([][]byte, 3, 3+len(refs)*3)
+	bs[0] = presentationBytesPrefix
+	bs[1] = []byte(holder)
+	bs[2] = []byte(challenge)
+
+	for i := range refs {
+		bs = append(bs, []byte(refs[i].Contract), []byte(refs[i].TemplateID), []byte(refs[i].ID))
+	}
+
+	return lengthPrefixedBytes(bs...)
+}
+
+// DisclosureBytes returns the bytes signed by holder for the disclosures of
+// the claims of the credential; like PresentationBytes, the challenge of
+// relying party is included.
+func DisclosureBytes(holder string, ref CredentialRef, disclosures []string, challenge string) []byte {
+	bs := make([][]byte, 6, 6+len(disclosures))
+	bs[0] = disclosureBytesPrefix
+	bs[1] = []byte(holder)
+	bs[2] = []byte(challenge)
+	bs[3] = []byte(ref.Contract)
+	bs[4] = []byte(ref.TemplateID)
+	bs[5] = []byte(ref.ID)
+
+	for i := range disclosures {
+		bs = append(bs, []byte(disclosures[i]))
+	}
+
+	return lengthPrefixedBytes(bs...)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var QuotaHint = hint.MustNewHint("mitum-credential-quota-v0.0.1")
+
+// Quota limits the credentials of a template which are neither revoked nor
+// renounced; zero means no limit.
+type Quota struct {
+	hint.BaseHinter
+	maxSupply    uint64
+	maxPerHolder uint64
+}
+
+func NewQuota(maxSupply, maxPerHolder uint64) Quota {
+	return Quota{
+		BaseHinter:   hint.NewBaseHinter(QuotaHint),
+		maxSupply:    maxSupply,
+		maxPerHolder: maxPerHolder,
+	}
+}
+
+func (q Quota) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(q.maxSupply),
+		util.Uint64ToBytes(q.maxPerHolder),
+	)
+}
+
+func (q Quota) IsValid([]byte) error {
+	if err := q.BaseHinter.IsValid(QuotaHint.Type().Bytes()); err != nil {
+		return err
+	}
+
+	if q.maxSupply < 1 && q.maxPerHolder < 1 {
+		return util.ErrInvalid.Errorf("empty quota")
+	}
+
+	if q.maxSupply > 0 && q.maxPerHolder > q.maxSupply {
+		return util.ErrInvalid.Errorf("max per holder over max supply, %d > %d", q.maxPerHolder, q.maxSupply)
+	}
+
+	return nil
+}
+
+// MaxSupply is the max number of credentials of the template issued by the
+// credential service, including the revoked and renounced ones.
+func (q Quota) MaxSupply() uint64 {
+	return q.maxSupply
+}
+
+// MaxPerHolder is the max number of credentials of the template for each
+// holder.
+func (q Quota) MaxPerHolder() uint64 {
+	return q.maxPerHolder
+}
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (q Quota) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":          q.Hint().String(),
+			"max_supply":     q.maxSupply,
+			"max_per_holder": q.maxPerHolder,
+		},
+	)
+}
+
+type QuotaBSONUnmarshaler struct {
+	Hint         string `bson:"_hint"`
+	MaxSupply    uint64 `bson:"max_supply"`
+	MaxPerHolder uint64 `bson:"max_per_holder"`
+}
+
+func (q *Quota) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Quota")
+
+	var u QuotaBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return q.unpack(enc, ht, u.MaxSupply, u.MaxPerHolder)
+}
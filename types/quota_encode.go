@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (q *Quota) unpack(_ encoder.Encoder, ht hint.Hint, maxSupply, maxPerHolder uint64) error {
+	q.BaseHinter = hint.NewBaseHinter(ht)
+	q.maxSupply = maxSupply
+	q.maxPerHolder = maxPerHolder
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type QuotaJSONMarshaler struct {
+	hint.BaseHinter
+	MaxSupply    uint64 `json:"max_supply"`
+	MaxPerHolder uint64 `json:"max_per_holder"`
+}
+
+func (q Quota) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(QuotaJSONMarshaler{
+		BaseHinter:   q.BaseHinter,
+		MaxSupply:    q.maxSupply,
+		MaxPerHolder: q.maxPerHolder,
+	})
+}
+
+type QuotaJSONUnmarshaler struct {
+	Hint         hint.Hint `json:"_hint"`
+	MaxSupply    uint64    `json:"max_supply"`
+	MaxPerHolder uint64    `json:"max_per_holder"`
+}
+
+func (q *Quota) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of Quota")
+
+	var u QuotaJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return q.unpack(enc, u.Hint, u.MaxSupply, u.MaxPerHolder)
+}
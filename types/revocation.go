@@ -0,0 +1,88 @@
+package types
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var RevocationHint = hint.MustNewHint("mitum-credential-revocation-v0.0.1")
+
+var (
+	MaxLengthRevocationReasonCode = 20
+	MaxLengthRevocationReason     = 1024
+)
+
+type Revocation struct {
+	hint.BaseHinter
+	reasonCode string
+	reason     string
+	revoker    base.Address
+	height     base.Height
+}
+
+func NewRevocation(reasonCode, reason string, revoker base.Address, height base.Height) Revocation {
+	return Revocation{
+		BaseHinter: hint.NewBaseHinter(RevocationHint),
+		reasonCode: reasonCode,
+		reason:     reason,
+		revoker:    revoker,
+		height:     height,
+	}
+}
+
+func (r Revocation) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		[]byte(r.reasonCode),
+		[]byte(r.reason),
+		r.revoker.Bytes(),
+		r.height.Bytes(),
+	)
+}
+
+func (r Revocation) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		r.BaseHinter,
+		r.revoker,
+		r.height,
+	); err != nil {
+		return err
+	}
+
+	return IsValidRevocationReason(r.reasonCode, r.reason)
+}
+
+func (r Revocation) ReasonCode() string {
+	return r.reasonCode
+}
+
+func (r Revocation) Reason() string {
+	return r.reason
+}
+
+func (r Revocation) Revoker() base.Address {
+	return r.revoker
+}
+
+func (r Revocation) Height() base.Height {
+	return r.height
+}
+
+// IsValidRevocationReason checks the optional reason code and reason text.
+func IsValidRevocationReason(reasonCode, reason string) error {
+	if l := utf8.RuneCountInString(reasonCode); l > MaxLengthRevocationReasonCode {
+		return util.ErrInvalid.Errorf("invalid length of reason code, 0 <= length <= %d", MaxLengthRevocationReasonCode)
+	}
+
+	if len(reasonCode) > 0 && !ReValidRevocationReasonCode.MatchString(reasonCode) {
+		return util.ErrInvalid.Errorf("invalid reason code, %q", reasonCode)
+	}
+
+	if l := utf8.RuneCountInString(reason); l > MaxLengthRevocationReason {
+		return util.ErrInvalid.Errorf("invalid length of reason, 0 <= length <= %d", MaxLengthRevocationReason)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r Revocation) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       r.Hint().String(),
+			"reason_code": r.reasonCode,
+			"reason":      r.reason,
+			"revoker":     r.revoker,
+			"height":      r.height,
+		},
+	)
+}
+
+type RevocationBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	ReasonCode string `bson:"reason_code"`
+	Reason     string `bson:"reason"`
+	Revoker    string `bson:"revoker"`
+	Height     int64  `bson:"height"`
+}
+
+func (r *Revocation) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of Revocation")
+
+	var u RevocationBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.ReasonCode, u.Reason, u.Revoker, u.Height)
+}
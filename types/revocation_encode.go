@@ -0,0 +1,26 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *Revocation) unpack(enc encoder.Encoder, ht hint.Hint, reasonCode, reason, revoker string, height int64) error {
+	e := util.StringError("failed to unpack of Revocation")
+
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.reasonCode = reasonCode
+	r.reason = reason
+	r.height = base.Height(height)
+
+	switch a, err := base.DecodeAddress(revoker, enc); {
+	case err != nil:
+		return e.Wrap(err)
+	default:
+		r.revoker = a
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/base"
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RevocationJSONMarshaler struct {
+	hint.BaseHinter
+	ReasonCode string       `json:"reason_code"`
+	Reason     string       `json:"reason"`
+	Revoker    base.Address `json:"revoker"`
+	Height     base.Height  `json:"height"`
+}
+
+func (r Revocation) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RevocationJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		ReasonCode: r.reasonCode,
+		Reason:     r.reason,
+		Revoker:    r.revoker,
+		Height:     r.height,
+	})
+}
+
+type RevocationJSONUnmarshaler struct {
+	Hint       hint.Hint `json:"_hint"`
+	ReasonCode string    `json:"reason_code"`
+	Reason     string    `json:"reason"`
+	Revoker    string    `json:"revoker"`
+	Height     int64     `json:"height"`
+}
+
+func (r *Revocation) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of Revocation")
+
+	var u RevocationJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.ReasonCode, u.Reason, u.Revoker, u.Height)
+}
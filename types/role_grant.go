@@ -0,0 +1,70 @@
+package types
+
+import (
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var RoleGrantHint = hint.MustNewHint("mitum-credential-role-grant-v0.0.1")
+
+var (
+	MaxLengthRoleTemplateID = 20
+	MaxRoleGrants           = 20
+)
+
+// RoleGrant gives the role for the template of the credential service; the
+// empty template id means every template of the service.
+type RoleGrant struct {
+	hint.BaseHinter
+	role       Role
+	templateID string
+}
+
+func NewRoleGrant(role Role, templateID string) RoleGrant {
+	return RoleGrant{
+		BaseHinter: hint.NewBaseHinter(RoleGrantHint),
+		role:       role,
+		templateID: templateID,
+	}
+}
+
+func (r RoleGrant) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		r.role.Bytes(),
+		[]byte(r.templateID),
+	)
+}
+
+func (r RoleGrant) IsValid([]byte) error {
+	if err := util.CheckIsValiders(nil, false,
+		r.BaseHinter,
+		r.role,
+	); err != nil {
+		return err
+	}
+
+	if l := utf8.RuneCountInString(r.templateID); l > MaxLengthRoleTemplateID {
+		return util.ErrInvalid.Errorf("invalid length of template ID, 0 <= length <= %d", MaxLengthRoleTemplateID)
+	}
+
+	return nil
+}
+
+func (r RoleGrant) Role() Role {
+	return r.role
+}
+
+func (r RoleGrant) TemplateID() string {
+	return r.templateID
+}
+
+// Covers reports whether the grant gives the role for the template.
+func (r RoleGrant) Covers(role Role, templateID string) bool {
+	return r.role == role && (len(r.templateID) < 1 || r.templateID == templateID)
+}
+
+func (r RoleGrant) Equal(b RoleGrant) bool {
+	return r.role == b.role && r.templateID == b.templateID
+}
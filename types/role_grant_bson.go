@@ -0,0 +1,41 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r RoleGrant) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":       r.Hint().String(),
+			"role":        r.role,
+			"template_id": r.templateID,
+		},
+	)
+}
+
+type RoleGrantBSONUnmarshaler struct {
+	Hint       string `bson:"_hint"`
+	Role       string `bson:"role"`
+	TemplateID string `bson:"template_id"`
+}
+
+func (r *RoleGrant) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of RoleGrant")
+
+	var u RoleGrantBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, ht, u.Role, u.TemplateID)
+}
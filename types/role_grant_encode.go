@@ -0,0 +1,14 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (r *RoleGrant) unpack(_ encoder.Encoder, ht hint.Hint, role, templateID string) error {
+	r.BaseHinter = hint.NewBaseHinter(ht)
+	r.role = Role(role)
+	r.templateID = templateID
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type RoleGrantJSONMarshaler struct {
+	hint.BaseHinter
+	Role       Role   `json:"role"`
+	TemplateID string `json:"template_id"`
+}
+
+func (r RoleGrant) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(RoleGrantJSONMarshaler{
+		BaseHinter: r.BaseHinter,
+		Role:       r.role,
+		TemplateID: r.templateID,
+	})
+}
+
+type RoleGrantJSONUnmarshaler struct {
+	Hint       hint.Hint `json:"_hint"`
+	Role       string    `json:"role"`
+	TemplateID string    `json:"template_id"`
+}
+
+func (r *RoleGrant) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of RoleGrant")
+
+	var u RoleGrantJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return r.unpack(enc, u.Hint, u.Role, u.TemplateID)
+}
@@ -0,0 +1,435 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// MaxDepthCredentialSchema limits the nesting of the subschemas.
+var MaxDepthCredentialSchema = 8
+
+var schemaTypes = map[string]struct{}{
+	"object":  {},
+	"array":   {},
+	"string":  {},
+	"number":  {},
+	"integer": {},
+	"boolean": {},
+	"null":    {},
+}
+
+// schemaAnnotations are accepted in schema, but not used for validation.
+var schemaAnnotations = map[string]struct{}{
+	"$schema":     {},
+	"$id":         {},
+	"title":       {},
+	"description": {},
+	"examples":    {},
+}
+
+// schemaNode is the restricted subset of JSON Schema; type, enum, const,
+// properties, required, additionalProperties, items, minItems, maxItems,
+// minLength, maxLength, pattern, minimum and maximum. The other keywords are
+// rejected rather than ignored.
+type schemaNode struct {
+	types                []string
+	enum                 []interface{}
+	properties           map[string]*schemaNode
+	required             []string
+	additionalProperties *bool
+	items                *schemaNode
+	minItems             *uint64
+	maxItems             *uint64
+	minLength            *uint64
+	maxLength            *uint64
+	pattern              *regexp.Regexp
+	minimum              *big.Rat
+	maximum              *big.Rat
+}
+
+func parseSchema(b []byte) (*schemaNode, error) {
+	return parseSchemaNode(b, 0)
+}
+
+func parseSchemaNode(b []byte, depth int) (*schemaNode, error) {
+	if depth > MaxDepthCredentialSchema {
+		return nil, util.ErrInvalid.Errorf("schema too deep, depth <= %d", MaxDepthCredentialSchema)
+	}
+
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, util.ErrInvalid.WithMessage(err, "schema not json object")
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	n := &schemaNode{}
+
+	for _, k := range keys {
+		v := m[k]
+
+		var err error
+
+		switch k {
+		case "type":
+			n.types, err = parseSchemaTypes(v)
+		case "enum":
+			err = decodeJSONValue(v, &n.enum)
+			if err == nil && len(n.enum) < 1 {
+				err = errors.Errorf("empty enum")
+			}
+		case "const":
+			var c interface{}
+			err = decodeJSONValue(v, &c)
+			n.enum = []interface{}{c}
+		case "properties":
+			n.properties, err = parseSchemaProperties(v, depth)
+		case "required":
+			err = json.Unmarshal(v, &n.required)
+		case "additionalProperties":
+			var a bool
+			err = json.Unmarshal(v, &a)
+			n.additionalProperties = &a
+		case "items":
+			n.items, err = parseSchemaNode(v, depth+1)
+		case "minItems":
+			n.minItems, err = parseSchemaUint(v)
+		case "maxItems":
+			n.maxItems, err = parseSchemaUint(v)
+		case "minLength":
+			n.minLength, err = parseSchemaUint(v)
+		case "maxLength":
+			n.maxLength, err = parseSchemaUint(v)
+		case "pattern":
+			var p string
+			if err = json.Unmarshal(v, &p); err == nil {
+				n.pattern, err = regexp.Compile(p)
+			}
+		case "minimum":
+			n.minimum, err = parseSchemaNumber(v)
+		case "maximum":
+			n.maximum, err = parseSchemaNumber(v)
+		default:
+			if _, found := schemaAnnotations[k]; !found {
+				return nil, util.ErrInvalid.Errorf("unsupported schema keyword, %q", k)
+			}
+		}
+
+		if err != nil {
+			return nil, util.ErrInvalid.WithMessage(err, "invalid schema keyword, %q", k)
+		}
+	}
+
+	return n, nil
+}
+
+func parseSchemaTypes(b []byte) ([]string, error) {
+	var ts []string
+
+	var t string
+	if err := json.Unmarshal(b, &t); err == nil {
+		ts = []string{t}
+	} else if err := json.Unmarshal(b, &ts); err != nil {
+		return nil, err
+	}
+
+	if len(ts) < 1 {
+		return nil, errors.Errorf("empty type")
+	}
+
+	for i := range ts {
+		if _, found := schemaTypes[ts[i]]; !found {
+			return nil, errors.Errorf("unknown type, %q", ts[i])
+		}
+	}
+
+	return ts, nil
+}
+
+func parseSchemaProperties(b []byte, depth int) (map[string]*schemaNode, error) {
+	var m map[string]json.RawMessage
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+
+	properties := make(map[string]*schemaNode, len(m))
+	for k, v := range m {
+		p, err := parseSchemaNode(v, depth+1)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "property %q", k)
+		}
+		properties[k] = p
+	}
+
+	return properties, nil
+}
+
+func parseSchemaUint(b []byte) (*uint64, error) {
+	var u uint64
+	if err := json.Unmarshal(b, &u); err != nil {
+		return nil, err
+	}
+
+	return &u, nil
+}
+
+func parseSchemaNumber(b []byte) (*big.Rat, error) {
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err != nil {
+		return nil, err
+	}
+
+	return numberRat(n)
+}
+
+func numberRat(n json.Number) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		return nil, errors.Errorf("invalid number, %q", n)
+	}
+
+	return r, nil
+}
+
+func decodeJSONValue(b []byte, v interface{}) error {
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+
+	if err := d.Decode(v); err != nil {
+		return err
+	}
+
+	if d.More() {
+		return errors.Errorf("trailing data after json value")
+	}
+
+	return nil
+}
+
+func (n *schemaNode) validate(v interface{}, path string) error {
+	if len(n.types) > 0 {
+		var matched bool
+		for i := range n.types {
+			if isSchemaType(n.types[i], v) {
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			return errors.Errorf("%s: expected %s", path, strings.Join(n.types, " or "))
+		}
+	}
+
+	if len(n.enum) > 0 {
+		var matched bool
+		for i := range n.enum {
+			if equalJSONValue(n.enum[i], v) {
+				matched = true
+
+				break
+			}
+		}
+
+		if !matched {
+			return errors.Errorf("%s: not in enum", path)
+		}
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		return n.validateObject(t, path)
+	case []interface{}:
+		return n.validateArray(t, path)
+	case string:
+		return n.validateString(t, path)
+	case json.Number:
+		return n.validateNumber(t, path)
+	default:
+		return nil
+	}
+}
+
+func (n *schemaNode) validateObject(m map[string]interface{}, path string) error {
+	for _, k := range n.required {
+		if _, found := m[k]; !found {
+			return errors.Errorf("%s: missing required property, %q", path, k)
+		}
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := n.validateProperty(k, m[k], path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *schemaNode) validateProperty(k string, v interface{}, path string) error {
+	p, found := n.properties[k]
+	switch {
+	case found:
+		return p.validate(v, path+"."+k)
+	case n.additionalProperties != nil && !*n.additionalProperties:
+		return errors.Errorf("%s: additional property not allowed, %q", path, k)
+	default:
+		return nil
+	}
+}
+
+func (n *schemaNode) validateArray(a []interface{}, path string) error {
+	if n.minItems != nil && uint64(len(a)) < *n.minItems {
+		return errors.Errorf("%s: items under min, %d < %d", path, len(a), *n.minItems)
+	}
+
+	if n.maxItems != nil && uint64(len(a)) > *n.maxItems {
+		return errors.Errorf("%s: items over max, %d > %d", path, len(a), *n.maxItems)
+	}
+
+	if n.items == nil {
+		return nil
+	}
+
+	for i := range a {
+		if err := n.items.validate(a[i], path+"["+strconv.Itoa(i)+"]"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (n *schemaNode) validateString(s, path string) error {
+	l := uint64(utf8.RuneCountInString(s))
+
+	if n.minLength != nil && l < *n.minLength {
+		return errors.Errorf("%s: length under min, %d < %d", path, l, *n.minLength)
+	}
+
+	if n.maxLength != nil && l > *n.maxLength {
+		return errors.Errorf("%s: length over max, %d > %d", path, l, *n.maxLength)
+	}
+
+	if n.pattern != nil && !n.pattern.MatchString(s) {
+		return errors.Errorf("%s: not match pattern, %q", path, n.pattern.String())
+	}
+
+	return nil
+}
+
+func (n *schemaNode) validateNumber(num json.Number, path string) error {
+	if n.minimum == nil && n.maximum == nil {
+		return nil
+	}
+
+	r, err := numberRat(num)
+	if err != nil {
+		return errors.WithMessage(err, path)
+	}
+
+	if n.minimum != nil && r.Cmp(n.minimum) < 0 {
+		return errors.Errorf("%s: under minimum, %s", path, n.minimum.RatString())
+	}
+
+	if n.maximum != nil && r.Cmp(n.maximum) > 0 {
+		return errors.Errorf("%s: over maximum, %s", path, n.maximum.RatString())
+	}
+
+	return nil
+}
+
+func isSchemaType(t string, v interface{}) bool {
+	switch t {
+	case "object":
+		_, ok := v.(map[string]interface{})
+		return ok
+	case "array":
+		_, ok := v.([]interface{})
+		return ok
+	case "string":
+		_, ok := v.(string)
+		return ok
+	case "number":
+		_, ok := v.(json.Number)
+		return ok
+	case "integer":
+		n, ok := v.(json.Number)
+		if !ok {
+			return false
+		}
+
+		r, err := numberRat(n)
+		return err == nil && r.IsInt()
+	case "boolean":
+		_, ok := v.(bool)
+		return ok
+	case "null":
+		return v == nil
+	default:
+		return false
+	}
+}
+
+func equalJSONValue(a, b interface{}) bool {
+	switch at := a.(type) {
+	case map[string]interface{}:
+		bt, ok := b.(map[string]interface{})
+		if !ok || len(at) != len(bt) {
+			return false
+		}
+
+		for k := range at {
+			if bv, found := bt[k]; !found || !equalJSONValue(at[k], bv) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		bt, ok := b.([]interface{})
+		if !ok || len(at) != len(bt) {
+			return false
+		}
+
+		for i := range at {
+			if !equalJSONValue(at[i], bt[i]) {
+				return false
+			}
+		}
+
+		return true
+	case json.Number:
+		bt, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		ar, aerr := numberRat(at)
+		br, berr := numberRat(bt)
+
+		return aerr == nil && berr == nil && ar.Cmp(br) == 0
+	default:
+		return a == b
+	}
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"sort"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// SDAlg is the hash algorithm of the claim digests, named as in SD-JWT.
+const SDAlg = "sha-256"
+
+var lengthSDDigest = base64.RawURLEncoding.EncodedLen(sha256.Size)
+
+// SDValue is the credential value of the selective disclosure template; only
+// the digests of the salted claims are kept on chain.
+type SDValue struct {
+	Digests []string `json:"_sd"`
+	Alg     string   `json:"_sd_alg"`
+}
+
+func ParseSDValue(value string) (SDValue, error) {
+	var v SDValue
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return v, util.ErrInvalid.WithMessage(err, "value not selective disclosure digests")
+	}
+
+	if v.Alg != SDAlg {
+		return v, util.ErrInvalid.Errorf("unknown _sd_alg, %q; %q expected", v.Alg, SDAlg)
+	}
+
+	if len(v.Digests) < 1 {
+		return v, util.ErrInvalid.Errorf("empty _sd")
+	}
+
+	if _, found := util.IsDuplicatedSlice(v.Digests, func(i string) (bool, string) { return true, i }); found {
+		return v, util.ErrInvalid.Errorf("duplicated digest in _sd")
+	}
+
+	for i := range v.Digests {
+		if b, err := base64.RawURLEncoding.DecodeString(v.Digests[i]); err != nil || len(b) != sha256.Size {
+			return v, util.ErrInvalid.Errorf("invalid digest in _sd, %q", v.Digests[i])
+		}
+	}
+
+	return v, nil
+}
+
+func (v SDValue) has(digest string) bool {
+	for i := range v.Digests {
+		if v.Digests[i] == digest {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Disclosure is a claim of the selective disclosure credential; it is encoded
+// as base64url of the json array, [salt, name, value].
+type Disclosure struct {
+	Salt  string
+	Name  string
+	Value interface{}
+}
+
+// NewDisclosures salts each claim with random salt and returns the
+// disclosures with the credential value of their digests. The digests are
+// sorted, so that the order does not reveal the claim names.
+func NewDisclosures(claims map[string]interface{}) (SDValue, map[string]string, error) {
+	disclosures := make(map[string]string, len(claims))
+	digests := make([]string, 0, len(claims))
+
+	for name := range claims {
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return SDValue{}, nil, errors.WithStack(err)
+		}
+
+		d, err := Disclosure{
+			Salt:  base64.RawURLEncoding.EncodeToString(salt),
+			Name:  name,
+			Value: claims[name],
+		}.Encode()
+		if err != nil {
+			return SDValue{}, nil, err
+		}
+
+		disclosures[name] = d
+		digests = append(digests, DisclosureDigest(d))
+	}
+
+	sort.Strings(digests)
+
+	return SDValue{Digests: digests, Alg: SDAlg}, disclosures, nil
+}
+
+func (d Disclosure) Encode() (string, error) {
+	b, err := json.Marshal([]interface{}{d.Salt, d.Name, d.Value})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func ParseDisclosure(s string) (Disclosure, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Disclosure{}, errors.Wrap(err, "disclosure not base64url")
+	}
+
+	var a []interface{}
+	if err := decodeJSONValue(b, &a); err != nil {
+		return Disclosure{}, errors.Wrap(err, "disclosure not json array")
+	}
+
+	if len(a) != 3 {
+		return Disclosure{}, errors.Errorf("disclosure not [salt, name, value]")
+	}
+
+	salt, ok := a[0].(string)
+	if !ok || len(salt) < 1 {
+		return Disclosure{}, errors.Errorf("invalid salt of disclosure")
+	}
+
+	name, ok := a[1].(string)
+	if !ok || len(name) < 1 {
+		return Disclosure{}, errors.Errorf("invalid claim name of disclosure")
+	}
+
+	return Disclosure{Salt: salt, Name: name, Value: a[2]}, nil
+}
+
+func DisclosureDigest(disclosure string) string {
+	h := sha256.Sum256([]byte(disclosure))
+
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+// VerifyDisclosures checks the disclosures presented by holder against the
+// digests of credential and returns the disclosed claims. The claims are
+// checked with the properties of the template schema, if any.
+func VerifyDisclosures(template Template, credential Credential, disclosures []string) (map[string]interface{}, error) {
+	if !template.SelectiveDisclosure() {
+		return nil, errors.Errorf("template not selective disclosure, %q", template.TemplateID())
+	}
+
+	v, err := ParseSDValue(credential.Value())
+	if err != nil {
+		return nil, err
+	}
+
+	claims := map[string]interface{}{}
+
+	for i := range disclosures {
+		if !v.has(DisclosureDigest(disclosures[i])) {
+			return nil, errors.Errorf("disclosure not in digests of credential, %q", disclosures[i])
+		}
+
+		d, err := ParseDisclosure(disclosures[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := claims[d.Name]; found {
+			return nil, errors.Errorf("duplicated claim disclosed, %q", d.Name)
+		}
+
+		if schema := template.Schema(); schema != nil {
+			if err := schema.ValidateClaim(d.Name, d.Value); err != nil {
+				return nil, err
+			}
+		}
+
+		claims[d.Name] = d.Value
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,185 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+)
+
+func testSDCredential(t *testing.T, claims map[string]interface{}) (Template, Credential, map[string]string) {
+	t.Helper()
+
+	v, disclosures, err := NewDisclosures(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := NewTemplate(
+		"sd", "sd", Date("2023-01-01"), Date("2099-01-01"),
+		false, false, false, false, true, nil, nil, nil, "", "", "", nil,
+	)
+
+	return template, NewCredential(nil, "sd", "c0", string(b), 0, 0, ""), disclosures
+}
+
+func TestDisclosureDigest(t *testing.T) {
+	// the example disclosure of SD-JWT
+	d := "WyI2cU1RdlJMNWhhaiIsICJmYW1pbHlfbmFtZSIsICJNw7ZiaXVzIl0"
+
+	if digest := DisclosureDigest(d); digest != "uutlBuYeMDyjLLTpf6Jxi7yNkEF35jdyWMn9U7b_RYY" {
+		t.Fatalf("digest not SD-JWT, %q", digest)
+	}
+
+	c, err := ParseDisclosure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c.Salt != "6qMQvRL5haj" || c.Name != "family_name" || c.Value != "Möbius" {
+		t.Fatalf("unexpected disclosure, %v", c)
+	}
+}
+
+func TestParseDisclosure(t *testing.T) {
+	encode := func(a []interface{}) string {
+		b, err := json.Marshal(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	cases := []struct {
+		name        string
+		disclosure  string
+		errContains string
+	}{
+		{name: "valid", disclosure: encode([]interface{}{"salt", "name", 1})},
+		{name: "not base64url", disclosure: "!!", errContains: "base64url"},
+		{name: "not array", disclosure: base64.RawURLEncoding.EncodeToString([]byte(`{"a":1}`)), errContains: "json array"},
+		{name: "trailing data", disclosure: base64.RawURLEncoding.EncodeToString([]byte(`["s","n",1] []`)), errContains: "json array"},
+		{name: "two items", disclosure: encode([]interface{}{"salt", "name"}), errContains: "[salt, name, value]"},
+		{name: "empty salt", disclosure: encode([]interface{}{"", "name", 1}), errContains: "salt"},
+		{name: "salt not string", disclosure: encode([]interface{}{1, "name", 1}), errContains: "salt"},
+		{name: "empty name", disclosure: encode([]interface{}{"salt", "", 1}), errContains: "claim name"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ParseDisclosure(tc.disclosure)
+
+			switch {
+			case len(tc.errContains) < 1 && err != nil:
+				t.Fatal(err)
+			case len(tc.errContains) > 0 && err == nil:
+				t.Fatal("invalid disclosure parsed")
+			case len(tc.errContains) > 0 && !bytes.Contains([]byte(err.Error()), []byte(tc.errContains)):
+				t.Fatalf("unexpected error, %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyDisclosures(t *testing.T) {
+	template, credential, disclosures := testSDCredential(t, map[string]interface{}{
+		"name": "alice",
+		"age":  30,
+	})
+
+	v, err := ParseSDValue(credential.Value())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(v.Digests) != 2 {
+		t.Fatalf("unexpected digests, %v", v.Digests)
+	}
+
+	for name, d := range disclosures {
+		if !v.has(DisclosureDigest(d)) {
+			t.Fatalf("digest of disclosure not in credential, %q", name)
+		}
+	}
+
+	claims, err := VerifyDisclosures(template, credential, []string{disclosures["name"]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(claims) != 1 || claims["name"] != "alice" {
+		t.Fatalf("unexpected claims, %v", claims)
+	}
+
+	tampered, err := Disclosure{Salt: "salt", Name: "name", Value: "bob"}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, err := ParseDisclosure(disclosures["name"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resalted, err := Disclosure{Salt: d.Salt, Name: d.Name, Value: "bob"}.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	notSD := NewTemplate(
+		"sd", "sd", Date("2023-01-01"), Date("2099-01-01"),
+		false, false, false, false, false, nil, nil, nil, "", "", "", nil,
+	)
+
+	cases := []struct {
+		name        string
+		template    Template
+		disclosures []string
+	}{
+		{name: "tampered disclosure", template: template, disclosures: []string{tampered}},
+		{name: "tampered value with same salt", template: template, disclosures: []string{resalted}},
+		{name: "truncated disclosure", template: template, disclosures: []string{disclosures["name"][1:]}},
+		{name: "duplicated claim", template: template, disclosures: []string{disclosures["name"], disclosures["name"]}},
+		{name: "not selective disclosure template", template: notSD, disclosures: []string{disclosures["name"]}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := VerifyDisclosures(tc.template, credential, tc.disclosures); err == nil {
+				t.Fatal("invalid disclosures verified")
+			}
+		})
+	}
+}
+
+func TestDisclosureBytes(t *testing.T) {
+	ref := CredentialRef{Contract: "contract", TemplateID: "template", ID: "id"}
+	b := DisclosureBytes("holder", ref, []string{"d0", "d1"}, "challenge")
+
+	cases := []struct {
+		name string
+		b    []byte
+	}{
+		{name: "other challenge", b: DisclosureBytes("holder", ref, []string{"d0", "d1"}, "challenge0")},
+		{name: "other holder", b: DisclosureBytes("holder0", ref, []string{"d0", "d1"}, "challenge")},
+		{name: "other credential", b: DisclosureBytes("holder", CredentialRef{Contract: "contract", TemplateID: "template", ID: "id0"}, []string{"d0", "d1"}, "challenge")},
+		{name: "tampered disclosure", b: DisclosureBytes("holder", ref, []string{"d0", "d2"}, "challenge")},
+		{name: "less disclosures", b: DisclosureBytes("holder", ref, []string{"d0"}, "challenge")},
+		{name: "moved boundary", b: DisclosureBytes("holder", ref, []string{"d0d", "1"}, "challenge")},
+		{name: "presentation", b: PresentationBytes("holder", []CredentialRef{ref}, "challenge")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if bytes.Equal(b, tc.b) {
+				t.Fatal("same bytes")
+			}
+		})
+	}
+}
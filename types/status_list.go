@@ -0,0 +1,86 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+var StatusListHint = hint.MustNewHint("mitum-credential-status-list-v0.0.1")
+
+// StatusListChunkBits is the number of bits of a chunk of the status list. The
+// status list of a template is stored in chunks, so that assigning or revoking
+// a credential rewrites only the chunk of its index.
+const StatusListChunkBits uint64 = 1 << 14
+
+// StatusList is the revocation bitstring of the credentials of a template.
+// Bit of index 0 is the most significant bit of the first byte, as in
+// StatusList2021.
+type StatusList struct {
+	hint.BaseHinter
+	size      uint64
+	bitstring []byte
+}
+
+func NewStatusList(size uint64, bitstring []byte) StatusList {
+	return StatusList{
+		BaseHinter: hint.NewBaseHinter(StatusListHint),
+		size:       size,
+		bitstring:  bitstring,
+	}
+}
+
+func (s StatusList) Bytes() []byte {
+	return util.ConcatBytesSlice(
+		util.Uint64ToBytes(s.size),
+		s.bitstring,
+	)
+}
+
+func (s StatusList) IsValid([]byte) error {
+	if err := s.BaseHinter.IsValid(nil); err != nil {
+		return err
+	}
+
+	if n := uint64(len(s.bitstring)); n != (s.size+7)/8 {
+		return util.ErrInvalid.Errorf("wrong length of bitstring for size %d, %d", s.size, n)
+	}
+
+	return nil
+}
+
+func (s StatusList) Size() uint64 {
+	return s.size
+}
+
+func (s StatusList) Bitstring() []byte {
+	return s.bitstring
+}
+
+func (s StatusList) IsSet(i uint64) bool {
+	if i >= s.size {
+		return false
+	}
+
+	return s.bitstring[i/8]&(0x80>>(i%8)) != 0
+}
+
+// Append returns the list grown by one unset bit and the index of the bit.
+func (s StatusList) Append() (StatusList, uint64) {
+	bitstring := make([]byte, (s.size+8)/8)
+	copy(bitstring, s.bitstring)
+
+	return NewStatusList(s.size+1, bitstring), s.size
+}
+
+// Set returns the list with the bit of index i set.
+func (s StatusList) Set(i uint64) (StatusList, error) {
+	if i >= s.size {
+		return StatusList{}, util.ErrInvalid.Errorf("status index out of range, %d >= %d", i, s.size)
+	}
+
+	bitstring := make([]byte, len(s.bitstring))
+	copy(bitstring, s.bitstring)
+	bitstring[i/8] |= 0x80 >> (i % 8)
+
+	return NewStatusList(s.size, bitstring), nil
+}
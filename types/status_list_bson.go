@@ -0,0 +1,41 @@
+package types
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+
+	bsonenc "github.com/ProtoconNet/mitum-currency/v3/digest/util/bson"
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s StatusList) MarshalBSON() ([]byte, error) {
+	return bsonenc.Marshal(
+		bson.M{
+			"_hint":     s.Hint().String(),
+			"size":      s.size,
+			"bitstring": s.bitstring,
+		},
+	)
+}
+
+type StatusListBSONUnmarshaler struct {
+	Hint      string `bson:"_hint"`
+	Size      uint64 `bson:"size"`
+	Bitstring []byte `bson:"bitstring"`
+}
+
+func (s *StatusList) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
+	e := util.StringError("failed to decode bson of StatusList")
+
+	var u StatusListBSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	ht, err := hint.ParseHint(u.Hint)
+	if err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, ht, u.Size, u.Bitstring)
+}
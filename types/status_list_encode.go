@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/ProtoconNet/mitum2/util/encoder"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+func (s *StatusList) unpack(_ encoder.Encoder, ht hint.Hint, size uint64, bitstring []byte) error {
+	e := util.StringError("failed to unpack of StatusList")
+
+	s.BaseHinter = hint.NewBaseHinter(ht)
+	s.size = size
+	s.bitstring = bitstring
+
+	if err := s.IsValid(nil); err != nil {
+		return e.Wrap(err)
+	}
+
+	return nil
+}
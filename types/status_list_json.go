@@ -0,0 +1,38 @@
+package types
+
+import (
+	"github.com/ProtoconNet/mitum2/util"
+	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
+	"github.com/ProtoconNet/mitum2/util/hint"
+)
+
+type StatusListJSONMarshaler struct {
+	hint.BaseHinter
+	Size      uint64 `json:"size"`
+	Bitstring []byte `json:"bitstring"`
+}
+
+func (s StatusList) MarshalJSON() ([]byte, error) {
+	return util.MarshalJSON(StatusListJSONMarshaler{
+		BaseHinter: s.BaseHinter,
+		Size:       s.size,
+		Bitstring:  s.bitstring,
+	})
+}
+
+type StatusListJSONUnmarshaler struct {
+	Hint      hint.Hint `json:"_hint"`
+	Size      uint64    `json:"size"`
+	Bitstring []byte    `json:"bitstring"`
+}
+
+func (s *StatusList) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
+	e := util.StringError("failed to decode json of StatusList")
+
+	var u StatusListJSONUnmarshaler
+	if err := enc.Unmarshal(b, &u); err != nil {
+		return e.Wrap(err)
+	}
+
+	return s.unpack(enc, u.Hint, u.Size, u.Bitstring)
+}
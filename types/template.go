@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	"github.com/ProtoconNet/mitum2/util/hint"
@@ -10,16 +12,22 @@ var TemplateHint = hint.MustNewHint("mitum-credential-template-v0.0.1")
 
 type Template struct {
 	hint.BaseHinter
-	templateID     string
-	templateName   string
-	serviceDate    Date
-	expirationDate Date
-	templateShare  Bool
-	multiAudit     Bool
-	displayName    string
-	subjectKey     string
-	description    string
-	creator        base.Address
+	templateID          string
+	templateName        string
+	serviceDate         Date
+	expirationDate      Date
+	templateShare       Bool
+	multiAudit          Bool
+	consentRequired     Bool
+	privateValue        Bool
+	selectiveDisclosure Bool
+	auditorSet          *AuditorSet
+	quota               *Quota
+	schema              *CredentialSchema
+	displayName         string
+	subjectKey          string
+	description         string
+	creator             base.Address
 }
 
 func NewTemplate(
@@ -28,24 +36,36 @@ func NewTemplate(
 	serviceDate,
 	expirationDate Date,
 	templateShare,
-	multiAudit Bool,
+	multiAudit,
+	consentRequired,
+	privateValue,
+	selectiveDisclosure Bool,
+	auditorSet *AuditorSet,
+	quota *Quota,
+	schema *CredentialSchema,
 	displayName,
 	subjectKey,
 	description string,
 	creator base.Address,
 ) Template {
 	return Template{
-		BaseHinter:     hint.NewBaseHinter(TemplateHint),
-		templateID:     templateID,
-		templateName:   templateName,
-		serviceDate:    serviceDate,
-		expirationDate: expirationDate,
-		templateShare:  templateShare,
-		multiAudit:     multiAudit,
-		displayName:    displayName,
-		subjectKey:     subjectKey,
-		description:    description,
-		creator:        creator,
+		BaseHinter:          hint.NewBaseHinter(TemplateHint),
+		templateID:          templateID,
+		templateName:        templateName,
+		serviceDate:         serviceDate,
+		expirationDate:      expirationDate,
+		templateShare:       templateShare,
+		multiAudit:          multiAudit,
+		consentRequired:     consentRequired,
+		privateValue:        privateValue,
+		selectiveDisclosure: selectiveDisclosure,
+		auditorSet:          auditorSet,
+		quota:               quota,
+		schema:              schema,
+		displayName:         displayName,
+		subjectKey:          subjectKey,
+		description:         description,
+		creator:             creator,
 	}
 }
 
@@ -92,10 +112,51 @@ func (t Template) IsValid([]byte) error {
 		return util.ErrInvalid.Errorf("expire date <= service date, %s <= %s", t.expirationDate, t.serviceDate)
 	}
 
+	if t.privateValue && t.selectiveDisclosure {
+		return util.ErrInvalid.Errorf("private value with selective disclosure")
+	}
+
+	if t.auditorSet != nil {
+		if !t.multiAudit {
+			return util.ErrInvalid.Errorf("auditor set of not multi-audit template")
+		}
+
+		if err := t.auditorSet.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if t.quota != nil {
+		if err := t.quota.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
+	if t.schema != nil {
+		if err := t.schema.IsValid(nil); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (t Template) Bytes() []byte {
+	var auditorSet []byte
+	if t.auditorSet != nil {
+		auditorSet = t.auditorSet.Bytes()
+	}
+
+	var quota []byte
+	if t.quota != nil {
+		quota = t.quota.Bytes()
+	}
+
+	var schema []byte
+	if t.schema != nil {
+		schema = t.schema.Bytes()
+	}
+
 	return util.ConcatBytesSlice(
 		[]byte(t.templateID),
 		[]byte(t.templateName),
@@ -103,6 +164,12 @@ func (t Template) Bytes() []byte {
 		t.expirationDate.Bytes(),
 		t.templateShare.Bytes(),
 		t.multiAudit.Bytes(),
+		t.consentRequired.FlagBytes(),
+		t.privateValue.FlagBytes(),
+		t.selectiveDisclosure.FlagBytes(),
+		auditorSet,
+		quota,
+		schema,
 		[]byte(t.displayName),
 		[]byte(t.subjectKey),
 		[]byte(t.description),
@@ -126,6 +193,22 @@ func (t Template) ExpirationDate() Date {
 	return t.expirationDate
 }
 
+// ServicePeriod returns the service window of template, [from, until).
+// The expiration date itself is still in service.
+func (t Template) ServicePeriod() (time.Time, time.Time, error) {
+	from, err := t.serviceDate.Parse()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	until, err := t.expirationDate.Parse()
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return from, until.AddDate(0, 0, 1), nil
+}
+
 func (t Template) TemplateShare() Bool {
 	return t.templateShare
 }
@@ -134,6 +217,43 @@ func (t Template) MultiAudit() Bool {
 	return t.multiAudit
 }
 
+// ConsentRequired reports whether credentials of the template are assigned
+// only with the consent signature of the holder.
+func (t Template) ConsentRequired() Bool {
+	return t.consentRequired
+}
+
+// PrivateValue reports whether credentials of the template keep only the
+// salted hash commitment of the value on chain.
+func (t Template) PrivateValue() Bool {
+	return t.privateValue
+}
+
+// SelectiveDisclosure reports whether credentials of the template keep only
+// the digests of the salted claims on chain, so that holder discloses some
+// of the claims.
+func (t Template) SelectiveDisclosure() Bool {
+	return t.selectiveDisclosure
+}
+
+// AuditorSet returns the auditors approving the assignments of multi-audit
+// template; nil for templates made before auditor sets.
+func (t Template) AuditorSet() *AuditorSet {
+	return t.auditorSet
+}
+
+// Quota returns the issuance limits of template; nil for no limit.
+func (t Template) Quota() *Quota {
+	return t.quota
+}
+
+// Schema returns the schema of the credential value; nil for the templates
+// without schema, which keep the value opaque. For the private value
+// template, the schema is checked with the value presented off chain.
+func (t Template) Schema() *CredentialSchema {
+	return t.schema
+}
+
 func (t Template) DisplayName() string {
 	return t.displayName
 }
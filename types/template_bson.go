@@ -9,35 +9,56 @@ import (
 )
 
 func (t Template) MarshalBSON() ([]byte, error) {
-	return bsonenc.Marshal(
-		bson.M{
-			"_hint":           t.Hint().String(),
-			"template_id":     t.templateID,
-			"template_name":   t.templateName,
-			"service_date":    t.serviceDate,
-			"expiration_date": t.expirationDate,
-			"template_share":  t.templateShare,
-			"multi_audit":     t.multiAudit,
-			"display_name":    t.displayName,
-			"subject_key":     t.subjectKey,
-			"description":     t.description,
-			"creator":         t.creator,
-		},
-	)
+	m := bson.M{
+		"_hint":                t.Hint().String(),
+		"template_id":          t.templateID,
+		"template_name":        t.templateName,
+		"service_date":         t.serviceDate,
+		"expiration_date":      t.expirationDate,
+		"template_share":       t.templateShare,
+		"multi_audit":          t.multiAudit,
+		"consent_required":     t.consentRequired,
+		"private_value":        t.privateValue,
+		"selective_disclosure": t.selectiveDisclosure,
+		"display_name":         t.displayName,
+		"subject_key":          t.subjectKey,
+		"description":          t.description,
+		"creator":              t.creator,
+	}
+
+	if t.auditorSet != nil {
+		m["auditor_set"] = t.auditorSet
+	}
+
+	if t.quota != nil {
+		m["quota"] = t.quota
+	}
+
+	if t.schema != nil {
+		m["schema"] = t.schema
+	}
+
+	return bsonenc.Marshal(m)
 }
 
 type TemplateBSONUnmarshaler struct {
-	Hint           string `bson:"_hint"`
-	TemplateID     string `bson:"template_id"`
-	TemplateName   string `bson:"template_name"`
-	ServiceDate    string `bson:"service_date"`
-	ExpirationDate string `bson:"expiration_date"`
-	TemplateShare  bool   `bson:"template_share"`
-	MultiAudit     bool   `bson:"multi_audit"`
-	DisplayName    string `bson:"display_name"`
-	SubjectKey     string `bson:"subject_key"`
-	Description    string `bson:"description"`
-	Creator        string `bson:"creator"`
+	Hint                string   `bson:"_hint"`
+	TemplateID          string   `bson:"template_id"`
+	TemplateName        string   `bson:"template_name"`
+	ServiceDate         string   `bson:"service_date"`
+	ExpirationDate      string   `bson:"expiration_date"`
+	TemplateShare       bool     `bson:"template_share"`
+	MultiAudit          bool     `bson:"multi_audit"`
+	ConsentRequired     bool     `bson:"consent_required"`
+	PrivateValue        bool     `bson:"private_value"`
+	SelectiveDisclosure bool     `bson:"selective_disclosure"`
+	AuditorSet          bson.Raw `bson:"auditor_set,omitempty"`
+	Quota               bson.Raw `bson:"quota,omitempty"`
+	Schema              bson.Raw `bson:"schema,omitempty"`
+	DisplayName         string   `bson:"display_name"`
+	SubjectKey          string   `bson:"subject_key"`
+	Description         string   `bson:"description"`
+	Creator             string   `bson:"creator"`
 }
 
 func (t *Template) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
@@ -53,6 +74,30 @@ func (t *Template) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
+	var auditorSet *AuditorSet
+	if len(u.AuditorSet) > 0 {
+		auditorSet = new(AuditorSet)
+		if err := auditorSet.DecodeBSON(u.AuditorSet, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var quota *Quota
+	if len(u.Quota) > 0 {
+		quota = new(Quota)
+		if err := quota.DecodeBSON(u.Quota, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var schema *CredentialSchema
+	if len(u.Schema) > 0 {
+		schema = new(CredentialSchema)
+		if err := schema.DecodeBSON(u.Schema, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return t.unpack(enc, ht,
 		u.TemplateID,
 		u.TemplateName,
@@ -60,6 +105,12 @@ func (t *Template) DecodeBSON(b []byte, enc *bsonenc.Encoder) error {
 		u.ExpirationDate,
 		u.TemplateShare,
 		u.MultiAudit,
+		u.ConsentRequired,
+		u.PrivateValue,
+		u.SelectiveDisclosure,
+		auditorSet,
+		quota,
+		schema,
 		u.DisplayName,
 		u.SubjectKey,
 		u.Description,
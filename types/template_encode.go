@@ -10,7 +10,10 @@ import (
 func (t *Template) unpack(enc encoder.Encoder, ht hint.Hint,
 	tmplID string,
 	tmplName, svcDate, expDate string,
-	share, audit bool,
+	share, audit, consent, private, sd bool,
+	auditorSet *AuditorSet,
+	quota *Quota,
+	schema *CredentialSchema,
 	dpName, subjKey, desc, creator string,
 ) error {
 	e := util.StringError("failed to unpack of Template")
@@ -22,6 +25,12 @@ func (t *Template) unpack(enc encoder.Encoder, ht hint.Hint,
 	t.expirationDate = Date(expDate)
 	t.templateShare = Bool(share)
 	t.multiAudit = Bool(audit)
+	t.consentRequired = Bool(consent)
+	t.privateValue = Bool(private)
+	t.selectiveDisclosure = Bool(sd)
+	t.auditorSet = auditorSet
+	t.quota = quota
+	t.schema = schema
 	t.displayName = dpName
 	t.subjectKey = subjKey
 	t.description = desc
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+
 	"github.com/ProtoconNet/mitum2/base"
 	"github.com/ProtoconNet/mitum2/util"
 	jsonenc "github.com/ProtoconNet/mitum2/util/encoder/json"
@@ -9,46 +11,64 @@ import (
 
 type TemplateJSONMarshaler struct {
 	hint.BaseHinter
-	TemplateID     string       `json:"template_id"`
-	TemplateName   string       `json:"template_name"`
-	ServiceDate    Date         `json:"service_date"`
-	ExpirationDate Date         `json:"expiration_date"`
-	TemplateShare  Bool         `json:"template_share"`
-	MultiAudit     Bool         `json:"multi_audit"`
-	DisplayName    string       `json:"display_name"`
-	SubjectKey     string       `json:"subject_key"`
-	Description    string       `json:"description"`
-	Creator        base.Address `json:"creator"`
+	TemplateID          string            `json:"template_id"`
+	TemplateName        string            `json:"template_name"`
+	ServiceDate         Date              `json:"service_date"`
+	ExpirationDate      Date              `json:"expiration_date"`
+	TemplateShare       Bool              `json:"template_share"`
+	MultiAudit          Bool              `json:"multi_audit"`
+	ConsentRequired     Bool              `json:"consent_required"`
+	PrivateValue        Bool              `json:"private_value"`
+	SelectiveDisclosure Bool              `json:"selective_disclosure"`
+	AuditorSet          *AuditorSet       `json:"auditor_set,omitempty"`
+	Quota               *Quota            `json:"quota,omitempty"`
+	Schema              *CredentialSchema `json:"schema,omitempty"`
+	DisplayName         string            `json:"display_name"`
+	SubjectKey          string            `json:"subject_key"`
+	Description         string            `json:"description"`
+	Creator             base.Address      `json:"creator"`
 }
 
 func (t Template) MarshalJSON() ([]byte, error) {
 	return util.MarshalJSON(TemplateJSONMarshaler{
-		BaseHinter:     t.BaseHinter,
-		TemplateID:     t.templateID,
-		TemplateName:   t.templateName,
-		ServiceDate:    t.serviceDate,
-		ExpirationDate: t.expirationDate,
-		TemplateShare:  t.templateShare,
-		MultiAudit:     t.multiAudit,
-		DisplayName:    t.displayName,
-		SubjectKey:     t.subjectKey,
-		Description:    t.description,
-		Creator:        t.creator,
+		BaseHinter:          t.BaseHinter,
+		TemplateID:          t.templateID,
+		TemplateName:        t.templateName,
+		ServiceDate:         t.serviceDate,
+		ExpirationDate:      t.expirationDate,
+		TemplateShare:       t.templateShare,
+		MultiAudit:          t.multiAudit,
+		ConsentRequired:     t.consentRequired,
+		PrivateValue:        t.privateValue,
+		SelectiveDisclosure: t.selectiveDisclosure,
+		AuditorSet:          t.auditorSet,
+		Quota:               t.quota,
+		Schema:              t.schema,
+		DisplayName:         t.displayName,
+		SubjectKey:          t.subjectKey,
+		Description:         t.description,
+		Creator:             t.creator,
 	})
 }
 
 type TemplateJSONUnmarshaler struct {
-	Hint           hint.Hint `json:"_hint"`
-	TemplateID     string    `json:"template_id"`
-	TemplateName   string    `json:"template_name"`
-	ServiceDate    string    `json:"service_date"`
-	ExpirationDate string    `json:"expiration_date"`
-	TemplateShare  bool      `json:"template_share"`
-	MultiAudit     bool      `json:"multi_audit"`
-	DisplayName    string    `json:"display_name"`
-	SubjectKey     string    `json:"subject_key"`
-	Description    string    `json:"description"`
-	Creator        string    `json:"creator"`
+	Hint                hint.Hint       `json:"_hint"`
+	TemplateID          string          `json:"template_id"`
+	TemplateName        string          `json:"template_name"`
+	ServiceDate         string          `json:"service_date"`
+	ExpirationDate      string          `json:"expiration_date"`
+	TemplateShare       bool            `json:"template_share"`
+	MultiAudit          bool            `json:"multi_audit"`
+	ConsentRequired     bool            `json:"consent_required"`
+	PrivateValue        bool            `json:"private_value"`
+	SelectiveDisclosure bool            `json:"selective_disclosure"`
+	AuditorSet          json.RawMessage `json:"auditor_set"`
+	Quota               json.RawMessage `json:"quota"`
+	Schema              json.RawMessage `json:"schema"`
+	DisplayName         string          `json:"display_name"`
+	SubjectKey          string          `json:"subject_key"`
+	Description         string          `json:"description"`
+	Creator             string          `json:"creator"`
 }
 
 func (t *Template) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
@@ -59,6 +79,30 @@ func (t *Template) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		return e.Wrap(err)
 	}
 
+	var auditorSet *AuditorSet
+	if len(u.AuditorSet) > 0 && string(u.AuditorSet) != "null" {
+		auditorSet = new(AuditorSet)
+		if err := auditorSet.DecodeJSON(u.AuditorSet, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var quota *Quota
+	if len(u.Quota) > 0 && string(u.Quota) != "null" {
+		quota = new(Quota)
+		if err := quota.DecodeJSON(u.Quota, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
+	var schema *CredentialSchema
+	if len(u.Schema) > 0 && string(u.Schema) != "null" {
+		schema = new(CredentialSchema)
+		if err := schema.DecodeJSON(u.Schema, enc); err != nil {
+			return e.Wrap(err)
+		}
+	}
+
 	return t.unpack(enc, u.Hint,
 		u.TemplateID,
 		u.TemplateName,
@@ -66,6 +110,12 @@ func (t *Template) DecodeJSON(b []byte, enc *jsonenc.Encoder) error {
 		u.ExpirationDate,
 		u.TemplateShare,
 		u.MultiAudit,
+		u.ConsentRequired,
+		u.PrivateValue,
+		u.SelectiveDisclosure,
+		auditorSet,
+		quota,
+		schema,
 		u.DisplayName,
 		u.SubjectKey,
 		u.Description,
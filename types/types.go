@@ -10,6 +10,8 @@ import (
 var (
 	ReValidDate = regexp.MustCompile(`^\d{4}\-(0[1-9]|1[012])\-(0[1-9]|[12][0-9]|3[01])$`)
 	DateLayout  = "2006-01-02"
+
+	ReValidRevocationReasonCode = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]*$`)
 )
 
 type Date string
@@ -42,3 +44,116 @@ func (b Bool) Bytes() []byte {
 	}
 	return []byte{0}
 }
+
+// FlagBytes returns the bytes only when b is true, so that a flag added later
+// does not change the bytes of the values hashed before it was added.
+func (b Bool) FlagBytes() []byte {
+	if b {
+		return []byte{1}
+	}
+	return nil
+}
+
+type TemplateStatus string
+
+const (
+	TemplateStatusActive     TemplateStatus = "active"
+	TemplateStatusDeprecated TemplateStatus = "deprecated"
+	TemplateStatusRemoved    TemplateStatus = "removed"
+)
+
+func (s TemplateStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s TemplateStatus) String() string {
+	return string(s)
+}
+
+func (s TemplateStatus) IsValid([]byte) error {
+	switch s {
+	case TemplateStatusActive, TemplateStatusDeprecated, TemplateStatusRemoved:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("wrong template status, %q", s)
+	}
+}
+
+// IsAssignable reports whether new credentials can be assigned with the template.
+func (s TemplateStatus) IsAssignable() bool {
+	return s == TemplateStatusActive
+}
+
+// Role is granted per service; the auditors are not granted by role, but
+// fixed by the auditor set of template.
+type Role string
+
+const (
+	RoleTemplateAdmin Role = "template-admin"
+	RoleIssuer        Role = "issuer"
+	RoleRevoker       Role = "revoker"
+)
+
+func (r Role) Bytes() []byte {
+	return []byte(r)
+}
+
+func (r Role) String() string {
+	return string(r)
+}
+
+func (r Role) IsValid([]byte) error {
+	switch r {
+	case RoleTemplateAdmin, RoleIssuer, RoleRevoker:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("wrong role, %q", r)
+	}
+}
+
+type CredentialStatus string
+
+const (
+	CredentialStatusActive    CredentialStatus = "active"
+	CredentialStatusSuspended CredentialStatus = "suspended"
+	CredentialStatusRevoked   CredentialStatus = "revoked"
+	CredentialStatusExpired   CredentialStatus = "expired"
+	CredentialStatusRenounced CredentialStatus = "renounced"
+)
+
+func (s CredentialStatus) Bytes() []byte {
+	return []byte(s)
+}
+
+func (s CredentialStatus) String() string {
+	return string(s)
+}
+
+func (s CredentialStatus) IsValid([]byte) error {
+	switch s {
+	case CredentialStatusActive, CredentialStatusSuspended, CredentialStatusRevoked, CredentialStatusExpired,
+		CredentialStatusRenounced:
+		return nil
+	default:
+		return util.ErrInvalid.Errorf("wrong credential status, %q", s)
+	}
+}
+
+// IsWithdrawn reports whether the credential is revoked by the issuer or
+// renounced by the holder.
+func (s CredentialStatus) IsWithdrawn() bool {
+	return s == CredentialStatusRevoked || s == CredentialStatusRenounced
+}
+
+// At returns expired when the active or suspended credential is over its
+// validity, in unix seconds, at t.
+func (s CredentialStatus) At(validUntil uint64, t time.Time) CredentialStatus {
+	switch s {
+	case CredentialStatusActive, CredentialStatusSuspended:
+		if u := t.Unix(); u >= 0 && validUntil <= uint64(u) {
+			return CredentialStatusExpired
+		}
+	}
+
+	return s
+}
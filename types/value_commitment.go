@@ -0,0 +1,84 @@
+package types
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ProtoconNet/mitum2/util"
+	"github.com/pkg/errors"
+)
+
+// ValueCommitmentPrefix marks the credential value which is the salted hash
+// commitment of the value kept off chain.
+const ValueCommitmentPrefix = "sha256:"
+
+var MinLengthValueSalt = 16
+
+// NewValueCommitment commits value with salt; the length of salt is hashed
+// first, so that the boundary of salt and value can not be moved.
+func NewValueCommitment(value, salt string) string {
+	h := sha256.New()
+	_, _ = h.Write(util.Uint64ToBytes(uint64(len(salt))))
+	_, _ = h.Write([]byte(salt))
+	_, _ = h.Write([]byte(value))
+
+	return ValueCommitmentPrefix + hex.EncodeToString(h.Sum(nil))
+}
+
+func IsValueCommitment(s string) bool {
+	if !strings.HasPrefix(s, ValueCommitmentPrefix) {
+		return false
+	}
+
+	d := s[len(ValueCommitmentPrefix):]
+	if len(d) != sha256.Size*2 || strings.ToLower(d) != d {
+		return false
+	}
+
+	_, err := hex.DecodeString(d)
+
+	return err == nil
+}
+
+// IsValidValueSalt checks the salt is long enough that the committed value
+// can not be guessed from the commitment.
+func IsValidValueSalt(salt string) error {
+	if l := utf8.RuneCountInString(salt); l < MinLengthValueSalt {
+		return util.ErrInvalid.Errorf("too short salt, %d < %d", l, MinLengthValueSalt)
+	}
+
+	return nil
+}
+
+// VerifyValueCommitment reports whether value and salt are committed by
+// commitment.
+func VerifyValueCommitment(commitment, value, salt string) bool {
+	if !IsValueCommitment(commitment) {
+		return false
+	}
+
+	return subtle.ConstantTimeCompare([]byte(commitment), []byte(NewValueCommitment(value, salt))) == 1
+}
+
+// VerifyCredentialValue checks the value and salt presented off chain against
+// the commitment of credential and the schema of template.
+func VerifyCredentialValue(template Template, credential Credential, value, salt string) error {
+	if !template.PrivateValue() {
+		return errors.Errorf("template not private value, %q", template.TemplateID())
+	}
+
+	if !VerifyValueCommitment(credential.Value(), value, salt) {
+		return errors.Errorf("value not match commitment of credential, %q", credential.ID())
+	}
+
+	if schema := template.Schema(); schema != nil {
+		if err := schema.Validate(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
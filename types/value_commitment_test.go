@@ -0,0 +1,139 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewValueCommitment(t *testing.T) {
+	salt := strings.Repeat("s", MinLengthValueSalt)
+	c := NewValueCommitment("value", salt)
+
+	if !IsValueCommitment(c) {
+		t.Fatalf("not value commitment, %q", c)
+	}
+
+	if c != NewValueCommitment("value", salt) {
+		t.Fatal("commitment not deterministic")
+	}
+
+	cases := []struct {
+		name  string
+		value string
+		salt  string
+	}{
+		{name: "other value", value: "value0", salt: salt},
+		{name: "other salt", value: "value", salt: salt + "0"},
+		// the length of salt is committed, so that the boundary of salt and
+		// value can not be moved.
+		{name: "moved boundary", value: "alue", salt: salt + "v"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if NewValueCommitment(tc.value, tc.salt) == c {
+				t.Fatal("same commitment")
+			}
+		})
+	}
+}
+
+func TestIsValueCommitment(t *testing.T) {
+	c := NewValueCommitment("value", strings.Repeat("s", MinLengthValueSalt))
+	d := c[len(ValueCommitmentPrefix):]
+
+	cases := []struct {
+		name string
+		s    string
+		ok   bool
+	}{
+		{name: "commitment", s: c, ok: true},
+		{name: "no prefix", s: d},
+		{name: "other prefix", s: "sha512:" + d},
+		{name: "upper case", s: ValueCommitmentPrefix + strings.ToUpper(d)},
+		{name: "short", s: c[:len(c)-2]},
+		{name: "long", s: c + "00"},
+		{name: "not hex", s: ValueCommitmentPrefix + strings.Repeat("z", len(d))},
+		{name: "plain value", s: "value"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if IsValueCommitment(tc.s) != tc.ok {
+				t.Fatalf("expected %v, %q", tc.ok, tc.s)
+			}
+		})
+	}
+}
+
+func TestIsValidValueSalt(t *testing.T) {
+	if err := IsValidValueSalt(strings.Repeat("s", MinLengthValueSalt)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := IsValidValueSalt(strings.Repeat("s", MinLengthValueSalt-1)); err == nil {
+		t.Fatal("short salt accepted")
+	}
+
+	// the length is counted in runes, not bytes.
+	if err := IsValidValueSalt(strings.Repeat("가", MinLengthValueSalt-1)); err == nil {
+		t.Fatal("short multibyte salt accepted")
+	}
+}
+
+func TestVerifyCredentialValue(t *testing.T) {
+	salt := strings.Repeat("s", MinLengthValueSalt)
+	value := `{"name":"alice","age":30}`
+
+	schema := NewCredentialSchema(`{"type":"object","properties":{"name":{"type":"string"},"age":{"type":"integer"}}}`)
+
+	newTemplate := func(privateValue Bool, schema *CredentialSchema) Template {
+		return NewTemplate(
+			"pv", "pv", Date("2023-01-01"), Date("2099-01-01"),
+			false, false, false, privateValue, false, nil, nil, schema, "", "", "", nil,
+		)
+	}
+
+	newCredential := func(value string) Credential {
+		return NewCredential(nil, "pv", "c0", value, 0, 0, "")
+	}
+
+	template := newTemplate(true, &schema)
+	credential := newCredential(NewValueCommitment(value, salt))
+
+	if err := VerifyCredentialValue(template, credential, value, salt); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyCredentialValue(newTemplate(true, nil), credential, value, salt); err != nil {
+		t.Fatal(err)
+	}
+
+	invalid := `{"name":1}`
+
+	cases := []struct {
+		name       string
+		template   Template
+		credential Credential
+		value      string
+		salt       string
+	}{
+		{name: "wrong value", template: template, credential: credential, value: `{"name":"bob","age":30}`, salt: salt},
+		{name: "wrong salt", template: template, credential: credential, value: value, salt: salt + "0"},
+		{name: "empty salt", template: template, credential: credential, value: value},
+		{name: "not private value template", template: newTemplate(false, &schema), credential: credential, value: value, salt: salt},
+		{name: "plain value credential", template: template, credential: newCredential(value), value: value, salt: salt},
+		{
+			name: "committed value not match schema", template: template,
+			credential: newCredential(NewValueCommitment(invalid, salt)), value: invalid, salt: salt,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := VerifyCredentialValue(tc.template, tc.credential, tc.value, tc.salt); err == nil {
+				t.Fatal("invalid value verified")
+			}
+		})
+	}
+}
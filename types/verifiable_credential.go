@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ProtoconNet/mitum2/util/localtime"
+)
+
+const (
+	DIDMethod             = "mitum"
+	VCProofTypeIssuerSign = "MitumIssuerSign"
+)
+
+var (
+	VCContextV1             = "https://www.w3.org/2018/credentials/v1"
+	StatusList2021ContextV1 = "https://w3id.org/vc/status-list/2021/v1"
+)
+
+// ContractDID returns the DID of the credential service of the contract in
+// the network, did:mitum:<network>:<contract>.
+func ContractDID(network, contract string) string {
+	return AccountDID(network, contract)
+}
+
+type VerifiableCredentialStatus struct {
+	ID                   string `json:"id"`
+	Type                 string `json:"type"`
+	StatusPurpose        string `json:"statusPurpose"`
+	StatusListIndex      string `json:"statusListIndex"`
+	StatusListCredential string `json:"statusListCredential"`
+}
+
+// VerifiableCredentialProof is the issuer sign of credential. The signature
+// is not over the document but over the bytes,
+//
+//	network id || IssuerSignBytes || created
+//
+// IssuerSignBytes is L(hint type of issuer sign) || L(contract) ||
+// L(credential), and credential is L(holder) || L(template id) || L(id) ||
+// L(value) || L(validFrom) || L(validUntil) || L(did). L(x) is the length of
+// x as 8 bytes big endian followed by x; addresses are their string,
+// validFrom and validUntil are 8 bytes big endian unix seconds and created
+// is the sign time in UTC formatted by time.Time.String, like
+// "2006-01-02 15:04:05.999999999 +0000 UTC". Every field is rendered in
+// the document; holder is credentialSubject.holder and value is the subject
+// of the template.
+type VerifiableCredentialProof struct {
+	Type               string `json:"type"`
+	Created            string `json:"created"`
+	VerificationMethod string `json:"verificationMethod"`
+	ProofPurpose       string `json:"proofPurpose"`
+	ProofValue         string `json:"proofValue"`
+}
+
+// VerifiableCredential is the W3C VC Data Model v1.1 document of a
+// credential; it is rendered from state and is not stored.
+type VerifiableCredential struct {
+	Context           []string                    `json:"@context"`
+	ID                string                      `json:"id"`
+	Type              []string                    `json:"type"`
+	Issuer            string                      `json:"issuer"`
+	IssuanceDate      string                      `json:"issuanceDate"`
+	ExpirationDate    string                      `json:"expirationDate,omitempty"`
+	CredentialSubject map[string]interface{}      `json:"credentialSubject"`
+	CredentialStatus  *VerifiableCredentialStatus `json:"credentialStatus,omitempty"`
+	Proof             *VerifiableCredentialProof  `json:"proof,omitempty"`
+}
+
+// NewVerifiableCredential renders the credential of the template. The
+// credentialStatus is set only when the credential has a status index and
+// statusListCredential, the URL of the status list, is given. The proof is
+// set only when the credential has the issuer sign.
+func NewVerifiableCredential(
+	network string,
+	contract string,
+	template Template,
+	credential Credential,
+	statusIndex *uint64,
+	statusListCredential string,
+	issuerSign *IssuerSign,
+) VerifiableCredential {
+	vc := VerifiableCredential{
+		Context: []string{VCContextV1},
+		ID: fmt.Sprintf("%s/%s/%s",
+			ContractDID(network, contract), credential.TemplateID(), credential.ID(),
+		),
+		Type:         []string{"VerifiableCredential"},
+		Issuer:       ContractDID(network, contract),
+		IssuanceDate: unixToRFC3339(credential.ValidFrom()),
+		CredentialSubject: map[string]interface{}{
+			"id":                  credential.DID(),
+			template.SubjectKey(): credential.Value(),
+		},
+	}
+
+	if credential.Holder() != nil {
+		vc.CredentialSubject["holder"] = credential.Holder().String()
+	}
+
+	if credential.ValidUntil() > 0 {
+		vc.ExpirationDate = unixToRFC3339(credential.ValidUntil())
+	}
+
+	if statusIndex != nil && len(statusListCredential) > 0 {
+		index := strconv.FormatUint(*statusIndex, 10)
+
+		vc.Context = append(vc.Context, StatusList2021ContextV1)
+		vc.CredentialStatus = &VerifiableCredentialStatus{
+			ID:                   statusListCredential + "#" + index,
+			Type:                 "StatusList2021Entry",
+			StatusPurpose:        "revocation",
+			StatusListIndex:      index,
+			StatusListCredential: statusListCredential,
+		}
+	}
+
+	if issuerSign != nil {
+		vc.Proof = &VerifiableCredentialProof{
+			Type:               VCProofTypeIssuerSign,
+			Created:            localtime.New(issuerSign.SignedAt()).Normalize().RFC3339(),
+			VerificationMethod: issuerSign.Signer().String(),
+			ProofPurpose:       "assertionMethod",
+			ProofValue:         issuerSign.Signature().String(),
+		}
+	}
+
+	return vc
+}
+
+func unixToRFC3339(u uint64) string {
+	return time.Unix(int64(u), 0).UTC().Format(time.RFC3339)
+}